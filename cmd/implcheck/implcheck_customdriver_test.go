@@ -0,0 +1,93 @@
+package implcheck_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"slices"
+	"testing"
+
+	"github.com/replu/goconmini-sendai-2026/customdriver"
+)
+
+// customdriver でラップしても database/sql から見える任意インターフェースが変わらないことを確認する
+
+func TestMySQL_CustomDriverParity(t *testing.T) {
+	parityCheck(t, mysqlConnector)
+}
+
+func TestPQ_CustomDriverParity(t *testing.T) {
+	parityCheck(t, pqConnector)
+}
+
+func parityCheck(t *testing.T, raw driver.Connector) {
+	t.Helper()
+	wrapped := customdriver.NewCustomConnector(raw, nil)
+
+	rawConn := connect(t, raw)
+	wrappedConn := connect(t, wrapped)
+	assertParity(t, "conn", connCheck(t, rawConn), connCheck(t, wrappedConn))
+
+	rawStmt := prepare(t, rawConn)
+	wrappedStmt := prepare(t, wrappedConn)
+	assertParity(t, "stmt", stmtCheck(t, rawStmt), stmtCheck(t, wrappedStmt))
+
+	// 同じコネクションで次のクエリを発行できるよう Rows はすぐに閉じる
+	rawRows := query(t, rawStmt)
+	wrappedRows := query(t, wrappedStmt)
+	assertParity(t, "rows", rowsCheck(t, rawRows), rowsCheck(t, wrappedRows))
+	rawRows.Close()
+	wrappedRows.Close()
+
+	if _, ok := rawConn.(driver.Queryer); ok {
+		rawTextRows := queryText(t, rawConn)
+		wrappedTextRows := queryText(t, wrappedConn)
+		assertParity(t, "text rows", rowsCheck(t, rawTextRows), rowsCheck(t, wrappedTextRows))
+		rawTextRows.Close()
+		wrappedTextRows.Close()
+	}
+}
+
+func assertParity(t *testing.T, kind string, raw, wrapped []string) {
+	t.Helper()
+	if !slices.Equal(raw, wrapped) {
+		t.Errorf("%s interfaces differ:\n raw:     %v\n wrapped: %v", kind, raw, wrapped)
+	}
+}
+
+func connect(t *testing.T, c driver.Connector) driver.Conn {
+	t.Helper()
+	conn, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func prepare(t *testing.T, conn driver.Conn) driver.Stmt {
+	t.Helper()
+	stmt, err := conn.Prepare("SELECT 1")
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	t.Cleanup(func() { stmt.Close() })
+	return stmt
+}
+
+func query(t *testing.T, stmt driver.Stmt) driver.Rows {
+	t.Helper()
+	rows, err := stmt.Query(nil)
+	if err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	return rows
+}
+
+func queryText(t *testing.T, conn driver.Conn) driver.Rows {
+	t.Helper()
+	rows, err := conn.(driver.Queryer).Query("SELECT 1", []driver.Value{})
+	if err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	return rows
+}
@@ -6,65 +6,87 @@ import (
 	"testing"
 )
 
-func check[T any](t *testing.T, v any) {
+// check は v が T を実装しているかをログに出し、実装している場合はインターフェース名を返す
+func check[T any](t *testing.T, v any) []string {
 	t.Helper()
 	var zero T
 	iface := fmt.Sprintf("%T", &zero)[1:]
 	if _, ok := v.(T); !ok {
 		t.Logf("%T does NOT implement %s", v, iface)
-	} else {
-		t.Logf("%T implements %s", v, iface)
+		return nil
 	}
+	t.Logf("%T implements %s", v, iface)
+	return []string{iface}
 }
 
-func driverCheck(t *testing.T, d driver.Driver) {
+// checkAll は checks を順に実行し、v が実装しているインターフェース名をまとめて返す
+func checkAll(t *testing.T, v any, checks ...func(*testing.T, any) []string) []string {
 	t.Helper()
 
-	check[driver.Driver](t, d)
-	check[driver.DriverContext](t, d)
+	var implemented []string
+	for _, c := range checks {
+		implemented = append(implemented, c(t, v)...)
+	}
+	return implemented
+}
+
+func driverCheck(t *testing.T, d driver.Driver) []string {
+	t.Helper()
+
+	return checkAll(t, d,
+		check[driver.Driver],
+		check[driver.DriverContext],
+	)
 }
 
-func connectorCheck(t *testing.T, c driver.Connector) {
+func connectorCheck(t *testing.T, c driver.Connector) []string {
 	t.Helper()
 
-	check[driver.Connector](t, c)
+	return checkAll(t, c,
+		check[driver.Connector],
+	)
 }
 
-func connCheck(t *testing.T, c driver.Conn) {
+func connCheck(t *testing.T, c driver.Conn) []string {
 	t.Helper()
 
-	check[driver.Conn](t, c)
-	check[driver.ConnBeginTx](t, c)
-	check[driver.ConnPrepareContext](t, c)
-	check[driver.Execer](t, c)
-	check[driver.ExecerContext](t, c)
-	check[driver.Queryer](t, c)
-	check[driver.QueryerContext](t, c)
-	check[driver.QueryerContext](t, c)
-	check[driver.Pinger](t, c)
-	check[driver.SessionResetter](t, c)
-	check[driver.Validator](t, c)
-	check[driver.NamedValueChecker](t, c)
+	return checkAll(t, c,
+		check[driver.Conn],
+		check[driver.ConnBeginTx],
+		check[driver.ConnPrepareContext],
+		check[driver.Execer],
+		check[driver.ExecerContext],
+		check[driver.Queryer],
+		check[driver.QueryerContext],
+		check[driver.Pinger],
+		check[driver.SessionResetter],
+		check[driver.Validator],
+		check[driver.NamedValueChecker],
+	)
 }
 
-func stmtCheck(t *testing.T, s driver.Stmt) {
+func stmtCheck(t *testing.T, s driver.Stmt) []string {
 	t.Helper()
 
-	check[driver.Stmt](t, s)
-	check[driver.StmtExecContext](t, s)
-	check[driver.StmtQueryContext](t, s)
-	check[driver.ColumnConverter](t, s)
-	check[driver.NamedValueChecker](t, s)
+	return checkAll(t, s,
+		check[driver.Stmt],
+		check[driver.StmtExecContext],
+		check[driver.StmtQueryContext],
+		check[driver.ColumnConverter],
+		check[driver.NamedValueChecker],
+	)
 }
 
-func rowsCheck(t *testing.T, r driver.Rows) {
+func rowsCheck(t *testing.T, r driver.Rows) []string {
 	t.Helper()
 
-	check[driver.Rows](t, r)
-	check[driver.RowsNextResultSet](t, r)
-	check[driver.RowsColumnTypeDatabaseTypeName](t, r)
-	check[driver.RowsColumnTypeLength](t, r)
-	check[driver.RowsColumnTypeNullable](t, r)
-	check[driver.RowsColumnTypePrecisionScale](t, r)
-	check[driver.RowsColumnTypeScanType](t, r)
+	return checkAll(t, r,
+		check[driver.Rows],
+		check[driver.RowsNextResultSet],
+		check[driver.RowsColumnTypeDatabaseTypeName],
+		check[driver.RowsColumnTypeLength],
+		check[driver.RowsColumnTypeNullable],
+		check[driver.RowsColumnTypePrecisionScale],
+		check[driver.RowsColumnTypeScanType],
+	)
 }
@@ -0,0 +1,36 @@
+package customdriver
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+type attrsKey struct{}
+
+// WithAttrs は ctx を使って発行された操作のログに attrs を追加する。
+// 既に ctx に属性がある場合はその後ろに追加する。
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	return context.WithValue(ctx, attrsKey{}, append(slices.Clip(AttrsFromContext(ctx)), attrs...))
+}
+
+// AttrsFromContext は WithAttrs で ctx に追加された属性を返す
+func AttrsFromContext(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+type queryNameKey struct{}
+
+// WithQueryName は ctx を使って発行された操作に名前を付ける。
+// 名前は Event.QueryName としてフックに渡され、ログやメトリクスのラベルに使われる。
+// sqlc が生成したクエリではヘッダーの名前が自動で使われるため、指定する必要はない
+func WithQueryName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, queryNameKey{}, name)
+}
+
+// QueryNameFromContext は WithQueryName で ctx に設定された名前を返す
+func QueryNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(queryNameKey{}).(string)
+	return name
+}
@@ -0,0 +1,66 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+// decodeLogs は JSONHandler の出力を 1 行ずつ map にする
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var r map[string]any
+		if err := dec.Decode(&r); err != nil {
+			t.Fatalf("failed to decode log: %v", err)
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestMySQL_ContextAttrs(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger))
+	defer db.Close()
+
+	ctx := WithAttrs(context.Background(), slog.String("request_id", "req-1"))
+	ctx = WithAttrs(ctx, slog.String("user", "alice"))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "attrs-user"); err != nil {
+		tx.Rollback()
+		t.Fatalf("INSERT in tx failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	var committed bool
+	for _, r := range decodeLogs(t, &buf) {
+		if _, ok := r["tx_id"]; !ok {
+			continue
+		}
+		if r["request_id"] != "req-1" || r["user"] != "alice" {
+			t.Errorf("expected context attrs in %q record, got %v", r["msg"], r)
+		}
+		if r["msg"] == "transaction committed" {
+			committed = true
+		}
+	}
+	if !committed {
+		t.Errorf("expected transaction committed record")
+	}
+}
@@ -3,6 +3,8 @@ package customdriver
 import (
 	"context"
 	"database/sql"
+	"io"
+	"log/slog"
 	"testing"
 )
 
@@ -75,6 +77,20 @@ func BenchmarkMySQL_CustomDriver_ExecQuery(b *testing.B) {
 	benchMySQLExecQuery(b, testMySQLDB)
 }
 
+func BenchmarkMySQL_CustomDriver_ExecQuery_RateLimited(b *testing.B) {
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithLogOptions(LogOptions{SampleRate: 0.1, RateLimit: 10})))
+	defer db.Close()
+	benchMySQLExecQuery(b, db)
+}
+
+func BenchmarkMySQL_CustomDriver_ExecQuery_Caller(b *testing.B) {
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithCaller()))
+	defer db.Close()
+	benchMySQLExecQuery(b, db)
+}
+
 func BenchmarkMySQL_RawDriver_Stmt(b *testing.B) {
 	benchMySQLStmt(b, rawMySQLDB)
 }
@@ -150,6 +166,13 @@ func BenchmarkPostgreSQL_CustomDriver_ExecQuery(b *testing.B) {
 	benchPgExecQuery(b, testPgDB)
 }
 
+func BenchmarkPostgreSQL_CustomDriver_ExecQuery_Caller(b *testing.B) {
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	db := sql.OpenDB(NewCustomConnector(pgConnector, logger, WithCaller()))
+	defer db.Close()
+	benchPgExecQuery(b, db)
+}
+
 func BenchmarkPostgreSQL_RawDriver_Stmt(b *testing.B) {
 	benchPgStmt(b, rawPgDB)
 }
@@ -0,0 +1,294 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBreakerConsecutiveFailures = 5
+	defaultBreakerWindow              = 10 * time.Second
+	defaultBreakerMinRequests         = 20
+	defaultBreakerOpenTimeout         = 5 * time.Second
+	defaultBreakerHalfOpenTrials      = 1
+)
+
+// BreakerState はサーキットブレーカーの状態
+type BreakerState string
+
+const (
+	// 全ての操作を実行する
+	BreakerClosed BreakerState = "closed"
+	// 操作を実行せずに BreakerOpenError を返す
+	BreakerOpen BreakerState = "open"
+	// HalfOpenTrials 件の操作だけを試し、結果で閉じるか開き直すかを決める
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// BreakerOptions はサーキットブレーカーを調整する
+type BreakerOptions struct {
+	// 失敗がこの回数続いたら開く。0 の場合は 5
+	ConsecutiveFailures int
+	// 0 より大きい場合、Window の期間の失敗の割合がこれ以上になったら開く。
+	// 期間の操作数が MinRequests 未満の場合は判定しない
+	FailureRate float64
+	// 0 の場合は 10 秒
+	Window time.Duration
+	// 0 の場合は 20
+	MinRequests int
+	// 開いてから半開にするまでの時間。0 の場合は 5 秒
+	OpenTimeout time.Duration
+	// 半開で試す操作の数。全て成功したら閉じる。0 の場合は 1
+	HalfOpenTrials int
+	// 失敗とみなすエラーの判定。nil の場合はコネクション系のエラー (Classify が connection_lost のもの) を失敗とみなす。
+	// 失敗とみなさないエラーはデータベースが応答したものとして成功に数える。context のキャンセルはどちらにも数えない
+	IsFailure func(error) bool
+	// nil でない場合、状態が変わったときに呼び出す
+	OnStateChange func(from, to BreakerState)
+}
+
+// BreakerOpenError はサーキットブレーカーが開いているために実行しなかった操作のエラー
+type BreakerOpenError struct {
+	State BreakerState
+	// 半開に移る時刻。半開で試行中の場合はゼロ値
+	RetryAt time.Time
+	// 回路を開いた原因のエラー
+	Cause error
+}
+
+func (e *BreakerOpenError) Error() string {
+	if e.Cause == nil {
+		return fmt.Sprintf("customdriver: circuit breaker is %s", e.State)
+	}
+	return fmt.Sprintf("customdriver: circuit breaker is %s: %v", e.State, e.Cause)
+}
+
+// BreakerStats はサーキットブレーカーの現在の状態
+type BreakerStats struct {
+	State               BreakerState
+	ConsecutiveFailures int
+	// 現在の Window の期間の操作数と失敗数
+	Requests int
+	Failures int
+	// 最後に開いた時刻と原因のエラー
+	OpenedAt  time.Time
+	LastError error
+}
+
+// Breaker は Connect と Prepare / Begin / Exec / Query / Ping の失敗を数え、データベースが落ちているとみなしたら回路を開いて
+// 内部ドライバーを呼ばずに BreakerOpenError を返すサーキットブレーカー。
+// OpenTimeout の後は半開にして一部の操作だけを試し、成功すれば閉じる。
+// 状態の変化は logger への警告と OnStateChange で通知する
+type Breaker struct {
+	logger *slog.Logger
+	opts   BreakerOptions
+	now    func() time.Time
+
+	mu          sync.Mutex
+	state       BreakerState
+	generation  uint64
+	consecutive int
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	lastErr     error
+	// 半開で実行中の試行と成功した試行の数
+	trials    int
+	successes int
+}
+
+// logger が nil の場合は状態の変化を出力しない。opts が nil の場合は既定値を使う
+func NewBreaker(logger *slog.Logger, opts *BreakerOptions) *Breaker {
+	b := &Breaker{
+		logger: logger,
+		now:    time.Now,
+		state:  BreakerClosed,
+	}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.ConsecutiveFailures <= 0 {
+		b.opts.ConsecutiveFailures = defaultBreakerConsecutiveFailures
+	}
+	if b.opts.Window <= 0 {
+		b.opts.Window = defaultBreakerWindow
+	}
+	if b.opts.MinRequests <= 0 {
+		b.opts.MinRequests = defaultBreakerMinRequests
+	}
+	if b.opts.OpenTimeout <= 0 {
+		b.opts.OpenTimeout = defaultBreakerOpenTimeout
+	}
+	if b.opts.HalfOpenTrials <= 0 {
+		b.opts.HalfOpenTrials = defaultBreakerHalfOpenTrials
+	}
+	if b.opts.IsFailure == nil {
+		b.opts.IsFailure = isConnectionFailure
+	}
+	return b
+}
+
+// WithBreaker は b で Connect と Prepare / Begin / Exec / Query / Ping を保護する。
+// 複数の CustomConnector で同じ Breaker を共有できる
+func WithBreaker(b *Breaker) Option {
+	return func(cfg *config) {
+		cfg.breaker = b
+	}
+}
+
+func isConnectionFailure(err error) bool {
+	return errors.Is(err, driver.ErrBadConn) || Classify(err) == CategoryConnectionLost
+}
+
+// State は現在の状態を返す
+func (b *Breaker) State() BreakerState {
+	return b.Stats().State
+}
+
+// Stats は現在の状態と失敗の記録を返す
+func (b *Breaker) Stats() BreakerStats {
+	b.mu.Lock()
+	from, to := b.advance(b.now())
+	stats := BreakerStats{
+		State:               b.state,
+		ConsecutiveFailures: b.consecutive,
+		Requests:            b.requests,
+		Failures:            b.failures,
+		OpenedAt:            b.openedAt,
+		LastError:           b.lastErr,
+	}
+	b.mu.Unlock()
+
+	b.notify(from, to, nil)
+	return stats
+}
+
+// allow は操作を実行してよいかを判定する。実行する場合は done に渡す世代を返し、
+// 回路が開いている場合は BreakerOpenError を返す。b が nil の場合は常に許可する
+func (b *Breaker) allow() (uint64, error) {
+	if b == nil {
+		return 0, nil
+	}
+	now := b.now()
+	b.mu.Lock()
+	from, to := b.advance(now)
+	var err error
+	switch {
+	case b.state == BreakerOpen:
+		err = &BreakerOpenError{State: b.state, RetryAt: b.openedAt.Add(b.opts.OpenTimeout), Cause: b.lastErr}
+	case b.state == BreakerHalfOpen && b.trials+b.successes >= b.opts.HalfOpenTrials:
+		err = &BreakerOpenError{State: b.state, Cause: b.lastErr}
+	case b.state == BreakerHalfOpen:
+		b.trials++
+	}
+	generation := b.generation
+	b.mu.Unlock()
+
+	b.notify(from, to, nil)
+	return generation, err
+}
+
+// done は allow で許可された操作の結果を記録する。
+// 状態が変わった後に終わった古い世代の操作は数えない
+func (b *Breaker) done(generation uint64, err error) {
+	if b == nil {
+		return
+	}
+	// driver.ErrSkip は内部ドライバーが何も実行せずに返すため、成功とも失敗とも数えない
+	skipped := err == driver.ErrSkip
+	failure := !skipped && err != nil && b.opts.IsFailure(err)
+	ignored := skipped || (!failure && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)))
+	now := b.now()
+
+	b.mu.Lock()
+	if generation != b.generation {
+		b.mu.Unlock()
+		return
+	}
+	var from, to BreakerState
+	switch b.state {
+	case BreakerClosed:
+		if ignored {
+			break
+		}
+		if now.Sub(b.windowStart) >= b.opts.Window {
+			b.windowStart = now
+			b.requests, b.failures = 0, 0
+		}
+		b.requests++
+		if !failure {
+			b.consecutive = 0
+			break
+		}
+		b.failures++
+		b.consecutive++
+		b.lastErr = err
+		if b.consecutive >= b.opts.ConsecutiveFailures ||
+			(b.opts.FailureRate > 0 && b.requests >= b.opts.MinRequests && float64(b.failures)/float64(b.requests) >= b.opts.FailureRate) {
+			from, to = b.transition(BreakerOpen, now)
+		}
+	case BreakerHalfOpen:
+		b.trials--
+		switch {
+		case ignored:
+		case failure:
+			b.lastErr = err
+			from, to = b.transition(BreakerOpen, now)
+		default:
+			b.successes++
+			if b.successes >= b.opts.HalfOpenTrials {
+				from, to = b.transition(BreakerClosed, now)
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	b.notify(from, to, err)
+}
+
+// advance は OpenTimeout を過ぎていれば半開にする。b.mu を取得して呼び出す
+func (b *Breaker) advance(now time.Time) (from, to BreakerState) {
+	if b.state == BreakerOpen && !now.Before(b.openedAt.Add(b.opts.OpenTimeout)) {
+		return b.transition(BreakerHalfOpen, now)
+	}
+	return "", ""
+}
+
+// transition は状態を to に変え、それまでの操作の結果を数えないよう世代を進める。b.mu を取得して呼び出す
+func (b *Breaker) transition(to BreakerState, now time.Time) (BreakerState, BreakerState) {
+	from := b.state
+	b.state = to
+	b.generation++
+	b.trials, b.successes = 0, 0
+	switch to {
+	case BreakerOpen:
+		b.openedAt = now
+	case BreakerClosed:
+		b.consecutive = 0
+		b.windowStart = now
+		b.requests, b.failures = 0, 0
+	}
+	return from, to
+}
+
+func (b *Breaker) notify(from, to BreakerState, err error) {
+	if from == to {
+		return
+	}
+	if b.logger != nil {
+		attrs := []slog.Attr{slog.String("from", string(from)), slog.String("to", string(to))}
+		if to == BreakerOpen && err != nil {
+			attrs = append(attrs, slog.Any("error", err), slog.String("error_category", string(Classify(err))))
+		}
+		b.logger.LogAttrs(context.Background(), slog.LevelWarn, "circuit breaker state changed", attrs...)
+	}
+	if b.opts.OnStateChange != nil {
+		b.opts.OnStateChange(from, to)
+	}
+}
@@ -0,0 +1,339 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+var errConnRefused = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+// switchConnector は down の間は接続に失敗するコネクター
+type switchConnector struct {
+	staticConnector
+	down  atomic.Bool
+	calls atomic.Int64
+}
+
+func (c *switchConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.calls.Add(1)
+	if c.down.Load() {
+		return nil, errConnRefused
+	}
+	return c.staticConnector.Connect(ctx)
+}
+
+// switchConn は err が設定されている間は Query が err を返すコネクション
+type switchConn struct {
+	txConn
+	mu      sync.Mutex
+	err     error
+	queries int
+}
+
+func (c *switchConn) set(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (c *switchConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	c.mu.Lock()
+	c.queries++
+	err := c.err
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return c.txConn.Query(query, args)
+}
+
+// newBreakerDB は fake clock を使う Breaker で保護した DB を返す
+func newBreakerDB(t *testing.T, connector driver.Connector, opts *BreakerOptions) (*sql.DB, *Breaker, *bytes.Buffer, *fakeClock) {
+	t.Helper()
+
+	var breaker *Breaker
+	db, buf, clock := newFakeClockDB(t, connector, func(logger *slog.Logger, clock *fakeClock) Option {
+		breaker = NewBreaker(logger, opts)
+		breaker.now = clock.Now
+		return WithBreaker(breaker)
+	})
+	return db, breaker, buf, clock
+}
+
+// =============================================================================
+// Circuit Breaker Tests
+// =============================================================================
+
+func TestBreaker_Connect(t *testing.T) {
+	var transitions []string
+	connector := &switchConnector{staticConnector: staticConnector{conn: &legacyConn{}}}
+	db, breaker, buf, clock := newBreakerDB(t, connector, &BreakerOptions{
+		ConsecutiveFailures: 3,
+		OpenTimeout:         time.Minute,
+		OnStateChange: func(from, to BreakerState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	ctx := context.Background()
+
+	connector.down.Store(true)
+	for range 3 {
+		if err := db.PingContext(ctx); err == nil {
+			t.Fatalf("expected ping to fail")
+		}
+	}
+	if got := breaker.State(); got != BreakerOpen {
+		t.Fatalf("expected open, got %s", got)
+	}
+
+	// 開いている間は内部のコネクターを呼ばずに失敗する
+	calls := connector.calls.Load()
+	err := db.PingContext(ctx)
+	var openErr *BreakerOpenError
+	if !errors.As(err, &openErr) || Classify(err) != CategoryCircuitOpen {
+		t.Fatalf("expected BreakerOpenError, got %v", err)
+	}
+	if !openErr.RetryAt.Equal(clock.Now().Add(time.Minute)) || Classify(openErr.Cause) != CategoryConnectionLost {
+		t.Errorf("unexpected error: %+v", openErr)
+	}
+	if connector.calls.Load() != calls {
+		t.Errorf("expected inner connector not to be called while open")
+	}
+	if stats := breaker.Stats(); stats.ConsecutiveFailures != 3 || stats.Failures != 3 || stats.LastError == nil {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	// 半開で失敗すると開き直す
+	clock.Advance(time.Minute)
+	if got := breaker.State(); got != BreakerHalfOpen {
+		t.Fatalf("expected half_open, got %s", got)
+	}
+	if err := db.PingContext(ctx); errors.As(err, &openErr) || err == nil {
+		t.Fatalf("expected trial to reach the connector, got %v", err)
+	}
+	if got := breaker.State(); got != BreakerOpen {
+		t.Fatalf("expected reopened, got %s", got)
+	}
+
+	// 半開で成功すると閉じる
+	connector.down.Store(false)
+	clock.Advance(time.Minute)
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatalf("expected trial to succeed, got %v", err)
+	}
+	if got := breaker.State(); got != BreakerClosed {
+		t.Fatalf("expected closed, got %s", got)
+	}
+
+	want := []string{"closed->open", "open->half_open", "half_open->open", "open->half_open", "half_open->closed"}
+	if fmt.Sprint(transitions) != fmt.Sprint(want) {
+		t.Errorf("expected transitions %v, got %v", want, transitions)
+	}
+	records := decodeLogs(t, buf)
+	if n := countMessages(records, "circuit breaker state changed"); n != len(want) {
+		t.Errorf("expected %d state change records, got %d", len(want), n)
+	}
+	for _, r := range records {
+		if r["to"] == "open" && (r["level"] != "WARN" || r["error_category"] != "connection_lost") {
+			t.Errorf("unexpected record: %v", r)
+		}
+	}
+}
+
+func TestBreaker_FailureRate(t *testing.T) {
+	conn := &switchConn{}
+	db, breaker, _, _ := newBreakerDB(t, &staticConnector{conn: conn}, &BreakerOptions{
+		ConsecutiveFailures: 100,
+		FailureRate:         0.5,
+		MinRequests:         4,
+	})
+	ctx := context.Background()
+
+	query := func() error {
+		rows, err := db.QueryContext(ctx, "SELECT id, name FROM users")
+		if err == nil {
+			rows.Close()
+		}
+		return err
+	}
+	// 接続も 1 件の操作に数える
+	for _, err := range []error{nil, mysql.ErrInvalidConn, nil, mysql.ErrInvalidConn} {
+		conn.set(err)
+		query()
+	}
+	if stats := breaker.Stats(); stats.State != BreakerClosed || stats.Requests != 5 || stats.Failures != 2 {
+		t.Fatalf("expected closed at 2/5 failures, got %+v", stats)
+	}
+	query()
+	if got := breaker.State(); got != BreakerOpen {
+		t.Fatalf("expected open at 3/6 failures, got %s", got)
+	}
+
+	conn.set(nil)
+	queries := conn.queries
+	if err := query(); Classify(err) != CategoryCircuitOpen {
+		t.Errorf("expected circuit_open, got %v", err)
+	}
+	if conn.queries != queries {
+		t.Errorf("expected query not to reach the connection while open")
+	}
+}
+
+func TestBreaker_IgnoresNonConnectionErrors(t *testing.T) {
+	conn := &switchConn{err: &mysql.MySQLError{Number: 1064}}
+	db, breaker, _, _ := newBreakerDB(t, &staticConnector{conn: conn}, &BreakerOptions{ConsecutiveFailures: 2})
+
+	for range 5 {
+		if _, err := db.QueryContext(context.Background(), "SELEC 1"); err == nil {
+			t.Fatalf("expected error")
+		}
+	}
+	// データベースが応答したエラーと context のキャンセルは失敗に数えない
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	conn.set(context.Canceled)
+	db.QueryContext(ctx, "SELECT 1")
+	if stats := breaker.Stats(); stats.State != BreakerClosed || stats.Failures != 0 {
+		t.Errorf("expected closed without failures, got %+v", stats)
+	}
+}
+
+func TestBreaker_IgnoresErrSkip(t *testing.T) {
+	breaker := NewBreaker(nil, &BreakerOptions{ConsecutiveFailures: 2, OpenTimeout: time.Minute})
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	breaker.now = clock.Now
+
+	// driver.ErrSkip は I/O の前に返されるため、連続した失敗をリセットしない
+	for _, err := range []error{driver.ErrBadConn, driver.ErrSkip} {
+		generation, _ := breaker.allow()
+		breaker.done(generation, err)
+	}
+	if stats := breaker.Stats(); stats.ConsecutiveFailures != 1 || stats.Requests != 1 {
+		t.Fatalf("expected driver.ErrSkip not to be counted, got %+v", stats)
+	}
+	generation, _ := breaker.allow()
+	breaker.done(generation, driver.ErrBadConn)
+	if got := breaker.State(); got != BreakerOpen {
+		t.Fatalf("expected open, got %s", got)
+	}
+
+	// 半開でも driver.ErrSkip では閉じず、試行の枠を返す
+	clock.Advance(time.Minute)
+	generation, _ = breaker.allow()
+	breaker.done(generation, driver.ErrSkip)
+	if got := breaker.State(); got != BreakerHalfOpen {
+		t.Fatalf("expected half_open after driver.ErrSkip, got %s", got)
+	}
+	generation, err := breaker.allow()
+	if err != nil {
+		t.Fatalf("expected the trial to be released, got %v", err)
+	}
+	breaker.done(generation, nil)
+	if got := breaker.State(); got != BreakerClosed {
+		t.Errorf("expected closed, got %s", got)
+	}
+}
+
+func TestBreaker_PrepareAndBegin(t *testing.T) {
+	conn := &switchConn{}
+	db, _, _, _ := newBreakerDB(t, &staticConnector{conn: conn}, &BreakerOptions{ConsecutiveFailures: 2})
+	ctx := context.Background()
+
+	conn.set(mysql.ErrInvalidConn)
+	for range 2 {
+		if _, err := db.QueryContext(ctx, "SELECT id, name FROM users"); err == nil {
+			t.Fatalf("expected error")
+		}
+	}
+
+	prepares := conn.prepares
+	if _, err := db.PrepareContext(ctx, "SELECT id, name FROM users"); Classify(err) != CategoryCircuitOpen {
+		t.Errorf("expected circuit_open from Prepare, got %v", err)
+	}
+	if conn.prepares != prepares {
+		t.Errorf("expected prepare not to reach the connection while open")
+	}
+	if _, err := db.BeginTx(ctx, nil); Classify(err) != CategoryCircuitOpen {
+		t.Errorf("expected circuit_open from BeginTx, got %v", err)
+	}
+}
+
+func TestBreaker_LegacyStmt(t *testing.T) {
+	db, breaker, _, _ := newBreakerDB(t, &staticConnector{conn: &legacyConn{}}, &BreakerOptions{ConsecutiveFailures: 1})
+	c, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	defer c.Close()
+
+	// Raw で直接呼び出したステートメントの Exec / Query も保護する
+	err = c.Raw(func(dc any) error {
+		stmt, err := dc.(driver.Conn).Prepare("SELECT id, name FROM users")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		generation, _ := breaker.allow()
+		breaker.done(generation, driver.ErrBadConn)
+		if _, err := stmt.Exec(nil); Classify(err) != CategoryCircuitOpen {
+			t.Errorf("expected circuit_open from stmt Exec, got %v", err)
+		}
+		if _, err := stmt.Query(nil); Classify(err) != CategoryCircuitOpen {
+			t.Errorf("expected circuit_open from stmt Query, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Raw failed: %v", err)
+	}
+}
+
+func TestBreaker_HalfOpenTrials(t *testing.T) {
+	breaker := NewBreaker(nil, &BreakerOptions{ConsecutiveFailures: 1, OpenTimeout: time.Minute, HalfOpenTrials: 2})
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	breaker.now = clock.Now
+
+	generation, _ := breaker.allow()
+	breaker.done(generation, driver.ErrBadConn)
+	clock.Advance(time.Minute)
+
+	// 半開では HalfOpenTrials 件だけを許可する
+	first, err1 := breaker.allow()
+	second, err2 := breaker.allow()
+	_, err3 := breaker.allow()
+	var openErr *BreakerOpenError
+	if err1 != nil || err2 != nil || !errors.As(err3, &openErr) || openErr.State != BreakerHalfOpen {
+		t.Fatalf("expected 2 trials, got %v %v %v", err1, err2, err3)
+	}
+	breaker.done(first, nil)
+	if got := breaker.State(); got != BreakerHalfOpen {
+		t.Fatalf("expected half_open until all trials succeed, got %s", got)
+	}
+	breaker.done(second, nil)
+	if got := breaker.State(); got != BreakerClosed {
+		t.Fatalf("expected closed, got %s", got)
+	}
+
+	// 状態が変わる前に始まった操作の結果は数えない
+	stale, _ := breaker.allow()
+	generation, _ = breaker.allow()
+	breaker.done(generation, driver.ErrBadConn)
+	breaker.done(stale, driver.ErrBadConn)
+	clock.Advance(time.Minute)
+	if stats := breaker.Stats(); stats.State != BreakerHalfOpen {
+		t.Errorf("expected stale result to be ignored, got %+v", stats)
+	}
+}
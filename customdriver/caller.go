@@ -0,0 +1,135 @@
+package customdriver
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const callerDepth = 32
+
+// Caller はクエリを発行したアプリケーションのフレーム
+type Caller struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// String は file:line を返す。フレームがない場合は空文字列を返す
+func (c Caller) String() string {
+	if c.File == "" {
+		return ""
+	}
+	return c.File + ":" + strconv.Itoa(c.Line)
+}
+
+// WithCaller は Prepare / Exec / Query / Begin / Commit / Rollback の Event.Caller に
+// 操作を発行したアプリケーションのフレームを設定し、ログに caller として出力する。
+//
+// database/sql と customdriver (テストを除く)、sqlc が生成したファイル (*.sql.go) のフレームは常に飛ばし、
+// skipPackages のパッケージ (例: "sqlc/mysqlquery") とそのサブパッケージのフレームも飛ばす。
+// skipPackages はパッケージパスの一部と '/' 区切りの要素単位で一致すればよい
+func WithCaller(skipPackages ...string) Option {
+	return func(cfg *config) {
+		cfg.callers = newCallerCache(skipPackages)
+	}
+}
+
+var (
+	customdriverPkg = reflect.TypeFor[Caller]().PkgPath()
+	// WithCaller がなくても呼び出し元が必要な場合 (StartScope) に使う
+	defaultCallers = newCallerCache(nil)
+)
+
+// callerCache はプログラムカウンターごとにアプリケーションのフレームかどうかをキャッシュする。
+// プログラムカウンターの数はバイナリの大きさで決まるため、キャッシュは増え続けない
+type callerCache struct {
+	skip []string
+
+	// uintptr から callerFrame へのキャッシュ
+	frames sync.Map
+}
+
+type callerFrame struct {
+	app    bool
+	caller Caller
+}
+
+func newCallerCache(skip []string) *callerCache {
+	return &callerCache{skip: skip}
+}
+
+// caller は呼び出し元をたどり、最初のアプリケーションのフレームを返す
+func (c *callerCache) caller() Caller {
+	var pcs [callerDepth]uintptr
+	n := runtime.Callers(2, pcs[:])
+	for _, pc := range pcs[:n] {
+		if f := c.frame(pc); f.app {
+			return f.caller
+		}
+	}
+	return Caller{}
+}
+
+func (c *callerCache) frame(pc uintptr) callerFrame {
+	if v, ok := c.frames.Load(pc); ok {
+		return v.(callerFrame)
+	}
+
+	// インライン展開された関数は 1 つのプログラムカウンターに複数のフレームを持つため、内側から順に調べる
+	var result callerFrame
+	frames := runtime.CallersFrames([]uintptr{pc})
+	for {
+		f, more := frames.Next()
+		if c.isApp(f) {
+			result = callerFrame{app: true, caller: Caller{Function: f.Function, File: f.File, Line: f.Line}}
+			break
+		}
+		if !more {
+			break
+		}
+	}
+	c.frames.Store(pc, result)
+	return result
+}
+
+func (c *callerCache) isApp(f runtime.Frame) bool {
+	if f.Function == "" || strings.HasSuffix(f.File, ".sql.go") {
+		return false
+	}
+	pkg := funcPackage(f.Function)
+	switch {
+	case pkg == "runtime" || pkg == "database/sql":
+		return false
+	case pkg == customdriverPkg:
+		return strings.HasSuffix(f.File, "_test.go")
+	}
+	path := "/" + pkg + "/"
+	for _, skip := range c.skip {
+		if strings.Contains(path, "/"+strings.Trim(skip, "/")+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// funcPackage は runtime.Frame.Function からパッケージパスを取り出す
+func funcPackage(fn string) string {
+	slash := strings.LastIndexByte(fn, '/')
+	dot := strings.IndexByte(fn[slash+1:], '.')
+	if dot < 0 {
+		return fn
+	}
+	return fn[:slash+1+dot]
+}
+
+// capturesCaller はアプリケーションが直接発行する操作かを返す
+func capturesCaller(op Op) bool {
+	switch op {
+	case OpPrepare, OpExec, OpQuery, OpBegin, OpCommit, OpRollback:
+		return true
+	}
+	return false
+}
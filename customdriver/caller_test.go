@@ -0,0 +1,113 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/replu/goconmini-sendai-2026/sqlc/mysqlquery"
+)
+
+// =============================================================================
+// Caller Tests
+// =============================================================================
+
+func TestCallerCache_IsApp(t *testing.T) {
+	cache := newCallerCache([]string{"sqlc/mysqlquery", "/internal/repo/"})
+	tests := []struct {
+		name  string
+		frame runtime.Frame
+		want  bool
+	}{
+		{"database/sql", runtime.Frame{Function: "database/sql.(*DB).QueryContext", File: "/go/src/database/sql/sql.go"}, false},
+		{"customdriver", runtime.Frame{Function: customdriverPkg + ".(*customConn).QueryContext", File: "/src/customdriver/conn.go"}, false},
+		{"customdriver test", runtime.Frame{Function: customdriverPkg + ".TestX", File: "/src/customdriver/x_test.go"}, true},
+		{"sqlc file", runtime.Frame{Function: "example.com/app/db.(*Queries).GetUser", File: "/src/app/db/query.sql.go"}, false},
+		{"skipped package", runtime.Frame{Function: "github.com/replu/goconmini-sendai-2026/sqlc/mysqlquery.(*Queries).GetUserByName", File: "/src/sqlc/mysqlquery/db.go"}, false},
+		{"skipped subpackage", runtime.Frame{Function: "example.com/app/internal/repo/user.Find", File: "/src/app/internal/repo/user/find.go"}, false},
+		{"similar package", runtime.Frame{Function: "example.com/app/internal/repository.Find", File: "/src/app/internal/repository/find.go"}, true},
+		{"application", runtime.Frame{Function: "example.com/app/handler.(*User).Get.func1", File: "/src/app/handler/user.go"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cache.isApp(tt.frame); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// queryUsers はログの caller に記録される関数
+func queryUsers(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, "SELECT name FROM users")
+	if err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+func TestCaller_Log(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, logger, WithCaller()))
+	defer db.Close()
+
+	for range 2 {
+		if err := queryUsers(context.Background(), db); err != nil {
+			t.Fatalf("QueryContext failed: %v", err)
+		}
+	}
+
+	records := decodeLogs(t, &buf)
+	var found int
+	for _, r := range records {
+		if r["msg"] != "sql queried" && r["msg"] != "sql rows closed" {
+			continue
+		}
+		found++
+		caller, ok := r["caller"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected caller in %v", r)
+		}
+		if fn, _ := caller["function"].(string); !strings.HasSuffix(fn, ".queryUsers") {
+			t.Errorf("unexpected function: %v", caller)
+		}
+		if file, _ := caller["file"].(string); !strings.HasSuffix(file, "caller_test.go") || caller["line"] == float64(0) {
+			t.Errorf("unexpected file: %v", caller)
+		}
+	}
+	if found != 4 {
+		t.Errorf("expected 4 records with caller, got %d", found)
+	}
+}
+
+func TestMySQL_CallerSqlc(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithCaller("sqlc/mysqlquery")))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "alice"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	if _, err := mysqlquery.New(db).GetUserByName(ctx, "alice"); err != nil {
+		t.Fatalf("GetUserByName failed: %v", err)
+	}
+
+	for _, r := range decodeLogs(t, &buf) {
+		caller, ok := r["caller"].(map[string]any)
+		if !ok {
+			continue
+		}
+		if fn, _ := caller["function"].(string); !strings.HasSuffix(fn, ".TestMySQL_CallerSqlc") {
+			t.Errorf("%s: unexpected caller %v", r["msg"], caller)
+		}
+	}
+}
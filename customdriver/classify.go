@@ -0,0 +1,173 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// Category は MySQL と PostgreSQL のエラーを共通にまとめた分類。
+// ログの error_category やアラート、アプリケーションのリトライの判断に使う
+type Category string
+
+const (
+	CategoryUniqueViolation     Category = "unique_violation"
+	CategoryForeignKeyViolation Category = "foreign_key_violation"
+	// NOT NULL・CHECK など、一意・外部キー以外の制約違反
+	CategoryConstraintViolation  Category = "constraint_violation"
+	CategoryDeadlock             Category = "deadlock"
+	CategorySerializationFailure Category = "serialization_failure"
+	CategoryLockTimeout          Category = "lock_timeout"
+	// context のキャンセル・タイムアウトと、サーバー側で中断されたクエリ
+	CategoryQueryCanceled   Category = "query_canceled"
+	CategoryConnectionLost  Category = "connection_lost"
+	CategorySyntaxError     Category = "syntax_error"
+	CategoryUndefinedObject Category = "undefined_object"
+	// 認証の失敗を含む
+	CategoryPermissionDenied Category = "permission_denied"
+	// 範囲外の値や長すぎる文字列など、値が不正な場合
+	CategoryDataException Category = "data_exception"
+	// WithBreaker のサーキットブレーカーが開いていたため実行しなかった操作
+	CategoryCircuitOpen Category = "circuit_open"
+	// 上のいずれにも当てはまらないエラー
+	CategoryUnknown Category = "unknown"
+)
+
+// MySQL のエラー番号ごとの分類
+var mysqlCategories = map[uint16]Category{
+	1022: CategoryUniqueViolation, // ER_DUP_KEY
+	1062: CategoryUniqueViolation, // ER_DUP_ENTRY
+	1586: CategoryUniqueViolation, // ER_DUP_ENTRY_WITH_KEY_NAME
+
+	1216: CategoryForeignKeyViolation, // ER_NO_REFERENCED_ROW
+	1217: CategoryForeignKeyViolation, // ER_ROW_IS_REFERENCED
+	1451: CategoryForeignKeyViolation, // ER_ROW_IS_REFERENCED_2
+	1452: CategoryForeignKeyViolation, // ER_NO_REFERENCED_ROW_2
+
+	1048: CategoryConstraintViolation, // ER_BAD_NULL_ERROR
+	1364: CategoryConstraintViolation, // ER_NO_DEFAULT_FOR_FIELD
+	3819: CategoryConstraintViolation, // ER_CHECK_CONSTRAINT_VIOLATED
+
+	1213: CategoryDeadlock, // ER_LOCK_DEADLOCK
+
+	1205: CategoryLockTimeout, // ER_LOCK_WAIT_TIMEOUT
+	3572: CategoryLockTimeout, // ER_LOCK_NOWAIT
+
+	1317: CategoryQueryCanceled, // ER_QUERY_INTERRUPTED
+	3024: CategoryQueryCanceled, // ER_QUERY_TIMEOUT
+
+	1053: CategoryConnectionLost, // ER_SERVER_SHUTDOWN
+	1927: CategoryConnectionLost, // ER_CONNECTION_KILLED
+	4031: CategoryConnectionLost, // ER_CLIENT_INTERACTION_TIMEOUT
+
+	1064: CategorySyntaxError, // ER_PARSE_ERROR
+	1149: CategorySyntaxError, // ER_SYNTAX_ERROR
+
+	1049: CategoryUndefinedObject, // ER_BAD_DB_ERROR
+	1054: CategoryUndefinedObject, // ER_BAD_FIELD_ERROR
+	1146: CategoryUndefinedObject, // ER_NO_SUCH_TABLE
+	1305: CategoryUndefinedObject, // ER_SP_DOES_NOT_EXIST
+
+	1044: CategoryPermissionDenied, // ER_DBACCESS_DENIED_ERROR
+	1045: CategoryPermissionDenied, // ER_ACCESS_DENIED_ERROR
+	1142: CategoryPermissionDenied, // ER_TABLEACCESS_DENIED_ERROR
+	1143: CategoryPermissionDenied, // ER_COLUMNACCESS_DENIED_ERROR
+	1227: CategoryPermissionDenied, // ER_SPECIFIC_ACCESS_DENIED_ERROR
+	1370: CategoryPermissionDenied, // ER_PROCACCESS_DENIED_ERROR
+
+	1264: CategoryDataException, // ER_WARN_DATA_OUT_OF_RANGE
+	1265: CategoryDataException, // WARN_DATA_TRUNCATED
+	1292: CategoryDataException, // ER_TRUNCATED_WRONG_VALUE
+	1366: CategoryDataException, // ER_TRUNCATED_WRONG_VALUE_FOR_FIELD
+	1406: CategoryDataException, // ER_DATA_TOO_LONG
+}
+
+// SQLSTATE ごとの分類。PostgreSQL と、MySQL のエラー番号で分類できない場合に使う
+var sqlStateCategories = map[string]Category{
+	"23505": CategoryUniqueViolation,
+	"23503": CategoryForeignKeyViolation,
+	"40P01": CategoryDeadlock,
+	"40001": CategorySerializationFailure,
+	"55P03": CategoryLockTimeout,
+	"57014": CategoryQueryCanceled,
+	"57P01": CategoryConnectionLost, // admin_shutdown
+	"57P02": CategoryConnectionLost, // crash_shutdown
+	"57P03": CategoryConnectionLost, // cannot_connect_now
+	"42601": CategorySyntaxError,
+	"42501": CategoryPermissionDenied,
+	"42P01": CategoryUndefinedObject, // undefined_table
+	"42703": CategoryUndefinedObject, // undefined_column
+	"42704": CategoryUndefinedObject, // undefined_object
+	"42883": CategoryUndefinedObject, // undefined_function
+	"3D000": CategoryUndefinedObject, // invalid_catalog_name
+}
+
+// SQLSTATE のクラス (先頭 2 文字) ごとの分類
+var sqlStateClassCategories = map[string]Category{
+	"08": CategoryConnectionLost,
+	"22": CategoryDataException,
+	"23": CategoryConstraintViolation,
+	"28": CategoryPermissionDenied,
+	"40": CategorySerializationFailure,
+	"42": CategorySyntaxError,
+}
+
+// Classify は err を Category に分類する。err が nil の場合は空文字列を返す。
+// ラップされたエラーもたどるため、database/sql が返したエラーにも使える
+func Classify(err error) Category {
+	if err == nil {
+		return ""
+	}
+	var openErr *BreakerOpenError
+	if errors.As(err, &openErr) {
+		return CategoryCircuitOpen
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return CategoryQueryCanceled
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if c, ok := mysqlCategories[mysqlErr.Number]; ok {
+			return c
+		}
+		return classifySQLState(string(mysqlErr.SQLState[:]))
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return classifySQLState(string(pqErr.Code))
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return classifySQLState(pgErr.Code)
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone),
+		errors.Is(err, mysql.ErrInvalidConn), errors.Is(err, io.ErrUnexpectedEOF):
+		return CategoryConnectionLost
+	case errors.As(err, &netErr):
+		return CategoryConnectionLost
+	}
+	return CategoryUnknown
+}
+
+func classifySQLState(state string) Category {
+	if c, ok := sqlStateCategories[state]; ok {
+		return c
+	}
+	if len(state) == 5 {
+		if c, ok := sqlStateClassCategories[state[:2]]; ok {
+			return c
+		}
+	}
+	return CategoryUnknown
+}
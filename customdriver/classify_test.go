@@ -0,0 +1,151 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// =============================================================================
+// Error Classification Tests
+// =============================================================================
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Category
+	}{
+		{"nil", nil, ""},
+		{"mysql duplicate entry", &mysql.MySQLError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}}, CategoryUniqueViolation},
+		{"mysql foreign key", &mysql.MySQLError{Number: 1452}, CategoryForeignKeyViolation},
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213, SQLState: [5]byte{'4', '0', '0', '0', '1'}}, CategoryDeadlock},
+		{"mysql lock wait timeout", &mysql.MySQLError{Number: 1205}, CategoryLockTimeout},
+		{"mysql parse error", &mysql.MySQLError{Number: 1064}, CategorySyntaxError},
+		{"mysql access denied", &mysql.MySQLError{Number: 1045}, CategoryPermissionDenied},
+		{"mysql unknown number by sqlstate", &mysql.MySQLError{Number: 9999, SQLState: [5]byte{'2', '2', '0', '0', '3'}}, CategoryDataException},
+		{"mysql invalid conn", mysql.ErrInvalidConn, CategoryConnectionLost},
+		{"pq unique", &pq.Error{Code: "23505"}, CategoryUniqueViolation},
+		{"pq serialization", &pq.Error{Code: "40001"}, CategorySerializationFailure},
+		{"pq not null by class", &pq.Error{Code: "23502"}, CategoryConstraintViolation},
+		{"pq admin shutdown", &pq.Error{Code: "57P01"}, CategoryConnectionLost},
+		{"pgconn deadlock", &pgconn.PgError{Code: "40P01"}, CategoryDeadlock},
+		{"pgconn lock not available", &pgconn.PgError{Code: "55P03"}, CategoryLockTimeout},
+		{"pgconn canceled", &pgconn.PgError{Code: "57014"}, CategoryQueryCanceled},
+		{"pgconn undefined table", &pgconn.PgError{Code: "42P01"}, CategoryUndefinedObject},
+		{"wrapped", fmt.Errorf("create user: %w", &pgconn.PgError{Code: "23503"}), CategoryForeignKeyViolation},
+		{"context canceled", context.Canceled, CategoryQueryCanceled},
+		{"deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), CategoryQueryCanceled},
+		{"bad conn", driver.ErrBadConn, CategoryConnectionLost},
+		{"conn done", sql.ErrConnDone, CategoryConnectionLost},
+		{"network", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, CategoryConnectionLost},
+		{"unknown", errors.New("boom"), CategoryUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClassify_EventAndLog(t *testing.T) {
+	var buf bytes.Buffer
+	hook := &recordingHook{}
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &failingConn{}}, logger, WithHooks(hook)))
+	defer db.Close()
+
+	if _, err := db.Exec("DELETE FROM users"); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	var found bool
+	for _, e := range hook.events {
+		if e.Op == OpExec {
+			found = true
+			if e.Category != CategoryUnknown {
+				t.Errorf("expected unknown category, got %q", e.Category)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected exec event")
+	}
+	for _, r := range decodeLogs(t, &buf) {
+		if r["msg"] == "sql execution failed" && r["error_category"] != "unknown" {
+			t.Errorf("unexpected record: %v", r)
+		}
+	}
+}
+
+func TestMySQL_Classify(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	hook := &recordingHook{}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook)))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (id, name) VALUES (1, 'alice')"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	_, err := db.ExecContext(ctx, "INSERT INTO users (id, name) VALUES (1, 'bob')")
+	if got := Classify(err); got != CategoryUniqueViolation {
+		t.Errorf("expected unique_violation, got %q (%v)", got, err)
+	}
+	_, err = db.ExecContext(ctx, "SELEC 1")
+	if got := Classify(err); got != CategorySyntaxError {
+		t.Errorf("expected syntax_error, got %q (%v)", got, err)
+	}
+	_, err = db.ExecContext(ctx, "SELECT * FROM missing_table")
+	if got := Classify(err); got != CategoryUndefinedObject {
+		t.Errorf("expected undefined_object, got %q (%v)", got, err)
+	}
+
+	var categories []Category
+	for _, e := range hook.events {
+		if e.Op == OpExec && e.Category != "" {
+			categories = append(categories, e.Category)
+		}
+	}
+	want := []Category{CategoryUniqueViolation, CategorySyntaxError, CategoryUndefinedObject}
+	if fmt.Sprint(categories) != fmt.Sprint(want) {
+		t.Errorf("expected event categories %v, got %v", want, categories)
+	}
+}
+
+func TestPostgreSQL_Classify(t *testing.T) {
+	truncatePgUsers(t)
+
+	db := sql.OpenDB(NewCustomConnector(pgConnector, nil))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (id, name) VALUES (1, 'alice')"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	_, err := db.ExecContext(ctx, "INSERT INTO users (id, name) VALUES (1, 'bob')")
+	if got := Classify(err); got != CategoryUniqueViolation {
+		t.Errorf("expected unique_violation, got %q (%v)", got, err)
+	}
+	_, err = db.ExecContext(ctx, "INSERT INTO users (name) VALUES (NULL)")
+	if got := Classify(err); got != CategoryConstraintViolation {
+		t.Errorf("expected constraint_violation, got %q (%v)", got, err)
+	}
+	_, err = db.ExecContext(ctx, "SELEC 1")
+	if got := Classify(err); got != CategorySyntaxError {
+		t.Errorf("expected syntax_error, got %q (%v)", got, err)
+	}
+}
@@ -0,0 +1,119 @@
+package customdriver
+
+import (
+	"context"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CommenterOptions は WithSQLCommenter でクエリに付けるコメントを調整する
+type CommenterOptions struct {
+	// application キーとして付けるサービス名。空の場合は付けない
+	ServiceName string
+	// true の場合、PrepareContext で準備するクエリにはコメントを付けない。
+	// traceparent はクエリごとに変わるため、ステートメントをキャッシュするドライバーや
+	// サーバー側でクエリ文字列ごとに集計する場合に有効にする
+	UnpreparedOnly bool
+}
+
+// WithSQLCommenter は ExecContext / QueryContext / PrepareContext で内部ドライバーに渡すクエリの末尾に
+// sqlcommenter 形式のコメント (/*application='api',traceparent='00-...'*/) を付ける。
+// コメントには traceparent・tracestate、サービス名、sqlc のヘッダーまたは WithQueryName の名前、WithCommentTag の値が含まれる。
+// フックに渡される Event.Query はコメントを付ける前のクエリのまま
+func WithSQLCommenter(opts CommenterOptions) Option {
+	return func(cfg *config) {
+		cfg.commenter = &opts
+	}
+}
+
+type commentTagsKey struct{}
+
+// WithCommentTag は ctx を使って発行されたクエリのコメントに key='value' を追加する。
+// WithSQLCommenter を指定していない場合は何もしない
+func WithCommentTag(ctx context.Context, key, value string) context.Context {
+	tags, _ := ctx.Value(commentTagsKey{}).(map[string]string)
+	tags = maps.Clone(tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	tags[key] = value
+	return context.WithValue(ctx, commentTagsKey{}, tags)
+}
+
+// commentQuery は WithSQLCommenter が指定されていれば query にコメントを付けて返す
+func (cfg *config) commentQuery(ctx context.Context, query string, prepare bool) string {
+	if cfg.commenter == nil || (prepare && cfg.commenter.UnpreparedOnly) {
+		return query
+	}
+	// 既にコメントを持つクエリには付けない
+	if strings.Contains(query, "/*") {
+		return query
+	}
+
+	tags, _ := ctx.Value(commentTagsKey{}).(map[string]string)
+	tags = maps.Clone(tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	if cfg.commenter.ServiceName != "" {
+		tags["application"] = cfg.commenter.ServiceName
+	}
+	// Event.QueryName と同じく sqlc のヘッダーの名前を優先する
+	name, _ := parseSQLCHeader(query)
+	if name == "" {
+		name = QueryNameFromContext(ctx)
+	}
+	if name != "" {
+		tags["query_name"] = name
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		tags["traceparent"] = "00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-" + sc.TraceFlags().String()
+		if ts := sc.TraceState().String(); ts != "" {
+			tags["tracestate"] = ts
+		}
+	}
+	if len(tags) == 0 {
+		return query
+	}
+	return appendComment(query, tags)
+}
+
+// appendComment は sqlcommenter の仕様に従って、キーの順に並べた key='value' のコメントを query の末尾に付ける。
+// キーと値は URL エンコードするため、コメントの終端や引用符を含むことはない
+func appendComment(query string, tags map[string]string) string {
+	var b strings.Builder
+	b.WriteString("/*")
+	for i, k := range slices.Sorted(maps.Keys(tags)) {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(commentEscape(k))
+		b.WriteString("='")
+		b.WriteString(commentEscape(tags[k]))
+		b.WriteByte('\'')
+	}
+	b.WriteString("*/")
+
+	// 末尾のセミコロンの前に入れる
+	trimmed := strings.TrimRight(query, " \t\r\n")
+	body, semicolon := strings.CutSuffix(trimmed, ";")
+	body = strings.TrimRight(body, " \t\r\n")
+	// 最後の行に行コメントがあるとコメントが飲み込まれるため、改行してから付ける
+	sep := " "
+	if last := body[strings.LastIndexByte(body, '\n')+1:]; strings.Contains(last, "--") || strings.Contains(last, "#") {
+		sep = "\n"
+	}
+	if semicolon {
+		return body + sep + b.String() + ";"
+	}
+	return body + sep + b.String()
+}
+
+// commentEscape は s を URL エンコードする。引用符も %27 にエンコードされる
+func commentEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,151 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// =============================================================================
+// SQL Commenter Tests
+// =============================================================================
+
+func TestAppendComment(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		tags  map[string]string
+		want  string
+	}{
+		{
+			name:  "sorted keys",
+			query: "SELECT 1",
+			tags:  map[string]string{"route": "/users", "application": "api"},
+			want:  "SELECT 1 /*application='api',route='%2Fusers'*/",
+		},
+		{
+			name:  "before semicolon",
+			query: "SELECT 1; ",
+			tags:  map[string]string{"application": "api"},
+			want:  "SELECT 1 /*application='api'*/;",
+		},
+		{
+			name:  "escape quote and comment terminator",
+			query: "SELECT 1",
+			tags:  map[string]string{"user name": "o'reilly */ DROP"},
+			want:  "SELECT 1 /*user%20name='o%27reilly%20%2A%2F%20DROP'*/",
+		},
+		{
+			name:  "after trailing line comment",
+			query: "SELECT 1 -- one",
+			tags:  map[string]string{"application": "api"},
+			want:  "SELECT 1 -- one\n/*application='api'*/",
+		},
+		{
+			name:  "trailing line comment before semicolon",
+			query: "SELECT 1 -- one\n;",
+			tags:  map[string]string{"application": "api"},
+			want:  "SELECT 1 -- one\n/*application='api'*/;",
+		},
+		{
+			name:  "sqlc header",
+			query: "-- name: GetOne :one\nSELECT 1",
+			tags:  map[string]string{"application": "api"},
+			want:  "-- name: GetOne :one\nSELECT 1 /*application='api'*/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appendComment(tt.query, tt.tags); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommentQuery_QueryName(t *testing.T) {
+	cfg := &config{commenter: &CommenterOptions{}}
+	ctx := WithQueryName(context.Background(), "FromContext")
+
+	if got := cfg.commentQuery(ctx, "SELECT 1", false); got != "SELECT 1 /*query_name='FromContext'*/" {
+		t.Errorf("expected name from context, got %q", got)
+	}
+	got := cfg.commentQuery(ctx, "-- name: GetOne :one\nSELECT 1", false)
+	if want := "-- name: GetOne :one\nSELECT 1 /*query_name='GetOne'*/"; got != want {
+		t.Errorf("expected sqlc name %q, got %q", want, got)
+	}
+}
+
+func TestMySQL_SQLCommenter(t *testing.T) {
+	ctx := context.Background()
+	tp := sdktrace.NewTracerProvider()
+	defer tp.Shutdown(ctx)
+
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil,
+		WithHooks(NewTracingHook(tp)),
+		WithSQLCommenter(CommenterOptions{ServiceName: "api"}),
+	))
+	defer db.Close()
+
+	ctx = WithQueryName(ctx, "CurrentQuery")
+	ctx = WithCommentTag(ctx, "route", "/users/{id}")
+
+	// 実行中のクエリ自身の文字列をプロセスリストから読み出す
+	var info string
+	if err := db.QueryRowContext(ctx, "SELECT INFO FROM information_schema.PROCESSLIST WHERE ID = CONNECTION_ID()").Scan(&info); err != nil {
+		t.Fatalf("SELECT from PROCESSLIST failed: %v", err)
+	}
+	for _, want := range []string{"application='api'", "query_name='CurrentQuery'", "route='%2Fusers%2F%7Bid%7D'", "traceparent='00-"} {
+		if !strings.Contains(info, want) {
+			t.Errorf("expected %s in %q", want, info)
+		}
+	}
+}
+
+func TestPostgreSQL_SQLCommenter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Unprepared", func(t *testing.T) {
+		db := sql.OpenDB(NewCustomConnector(pgConnector, nil, WithSQLCommenter(CommenterOptions{ServiceName: "api"})))
+		defer db.Close()
+
+		var query string
+		if err := db.QueryRowContext(ctx, "SELECT query FROM pg_stat_activity WHERE pid = pg_backend_pid()").Scan(&query); err != nil {
+			t.Fatalf("SELECT from pg_stat_activity failed: %v", err)
+		}
+		if !strings.HasSuffix(query, "/*application='api'*/") {
+			t.Errorf("expected sqlcommenter comment in %q", query)
+		}
+	})
+
+	t.Run("UnpreparedOnly", func(t *testing.T) {
+		hook := &recordingHook{name: "commenter"}
+		db := sql.OpenDB(NewCustomConnector(pgConnector, nil,
+			WithHooks(hook),
+			WithSQLCommenter(CommenterOptions{ServiceName: "api", UnpreparedOnly: true}),
+		))
+		defer db.Close()
+
+		stmt, err := db.PrepareContext(ctx, "SELECT query FROM pg_stat_activity WHERE pid = pg_backend_pid()")
+		if err != nil {
+			t.Fatalf("Prepare failed: %v", err)
+		}
+		defer stmt.Close()
+		var query string
+		if err := stmt.QueryRowContext(ctx).Scan(&query); err != nil {
+			t.Fatalf("stmt QueryRow failed: %v", err)
+		}
+		if strings.Contains(query, "/*") {
+			t.Errorf("expected prepared query without comment, got %q", query)
+		}
+
+		e, ok := hook.find(OpPrepare)
+		if !ok || strings.Contains(e.Query, "/*") {
+			t.Errorf("expected original query in event, got %q", e.Query)
+		}
+	})
+}
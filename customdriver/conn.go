@@ -3,8 +3,6 @@ package customdriver
 import (
 	"context"
 	"database/sql/driver"
-	"log/slog"
-	"time"
 )
 
 var (
@@ -12,6 +10,8 @@ var (
 	_ driver.Pinger             = (*customConn)(nil)
 	_ driver.SessionResetter    = (*customConn)(nil)
 	_ driver.Validator          = (*customConn)(nil)
+	_ driver.Execer             = (*customConn)(nil)
+	_ driver.Queryer            = (*customConn)(nil)
 	_ driver.QueryerContext     = (*customConn)(nil)
 	_ driver.ExecerContext      = (*customConn)(nil)
 	_ driver.ConnPrepareContext = (*customConn)(nil)
@@ -19,184 +19,335 @@ var (
 	_ driver.NamedValueChecker  = (*customConn)(nil)
 )
 
+// customConn は全ての任意インターフェースのメソッドを持つが、
+// database/sql には wrapConn で内部の Conn と同じインターフェースだけを公開する。
+// そのため任意インターフェースのメソッドは内部の Conn が実装している前提で呼び出す。
 type customConn struct {
-	conn   driver.Conn
-	logger *slog.Logger
+	conn driver.Conn
+	cfg  *config
+	id   uint64
+	// 実行中のトランザクション。なければ nil
+	tx *customTx
+	// 内部ドライバーで同じ接続先に新しいコネクションを開く。EXPLAIN の実行とリトライ時の再接続に使う
+	connect func(context.Context) (driver.Conn, error)
+	// 再接続で内部の Conn を置き換えた回数。customStmt が準備し直すかの判定に使う
+	generation uint64
+}
+
+func newCustomConn(conn driver.Conn, cfg *config, id uint64, connect func(context.Context) (driver.Conn, error)) driver.Conn {
+	return wrapConn(&customConn{
+		conn:    conn,
+		cfg:     cfg,
+		id:      id,
+		connect: connect,
+	})
+}
+
+func (c *customConn) newEvent(op Op) *Event {
+	e := &Event{Op: op, ConnID: c.id, DBSystem: c.cfg.system}
+	if c.tx != nil {
+		e.TxID = c.tx.id
+	}
+	if c.cfg.slowQuery.Explain {
+		e.connect = c.connect
+	}
+	if c.cfg.callers != nil && capturesCaller(op) {
+		e.Caller = c.cfg.callers.caller()
+	}
+	return e
+}
+
+// context を受け取らない操作で使う context。
+// トランザクション中であれば BeginTx に渡された context の値を引き継ぐ
+func (c *customConn) baseContext() context.Context {
+	if c.tx != nil {
+		return c.tx.ctx
+	}
+	return context.Background()
+}
+
+// finishStatement は Exec / Query のフックの After を呼び、トランザクションとスコープに記録する。
+// driver.ErrSkip の場合は database/sql が別の経路で実行し直すため記録しない
+func (c *customConn) finishStatement(ctx context.Context, e *Event, err error) {
+	c.cfg.hooks.after(ctx, e, err)
+	if err == driver.ErrSkip {
+		return
+	}
+	if c.tx != nil {
+		c.tx.record(e)
+	}
+	recordScope(ctx, e)
+}
+
+// setResult は result の影響を受けた行数と最後に挿入した ID を e に設定する。
+// lib/pq や pgx の LastInsertId のように取得できない値は 0 のままにする
+func (e *Event) setResult(result driver.Result) {
+	if result == nil {
+		return
+	}
+	if n, err := result.RowsAffected(); err == nil {
+		e.RowsAffected = n
+	}
+	if id, err := result.LastInsertId(); err == nil {
+		e.LastInsertID = id
+	}
+}
+
+// newRetrier はトランザクション外の操作に WithRetry のリトライを適用する
+func (c *customConn) newRetrier(ctx context.Context, op Op, query string) *retrier {
+	if c.tx != nil {
+		return nil
+	}
+	return c.cfg.newRetrier(ctx, op, query)
+}
+
+// retry は r がリトライすると判定した場合に待ち、err がコネクションの切断であれば再接続する。
+// 再実行する場合は true を返す
+func (c *customConn) retry(ctx context.Context, r *retrier, err error) bool {
+	if !r.sleep(ctx) {
+		return false
+	}
+	if !isConnectionFailure(err) {
+		return true
+	}
+	return c.reconnect(ctx) == nil
+}
+
+// reconnect は新しいコネクションを開き、切れた内部の Conn を閉じて置き換える。
+// トランザクション外でのみ呼び出す
+func (c *customConn) reconnect(ctx context.Context) error {
+	conn, id, err := c.cfg.dial(ctx, c.connect, nil)
+	if err != nil {
+		return err
+	}
+	// 切れたコネクションを閉じるエラーは無視する
+	_ = c.Close()
+	c.conn = conn
+	c.id = id
+	c.generation++
+	return nil
 }
 
 func (c *customConn) Prepare(query string) (driver.Stmt, error) {
-	stmt, err := c.conn.Prepare(query)
+	e := c.newEvent(OpPrepare)
+	e.setQuery(query)
+	ctx := c.cfg.hooks.before(c.baseContext(), e)
+	var stmt driver.Stmt
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		stmt, err = c.conn.Prepare(query)
+		c.cfg.breaker.done(generation, err)
+	}
+	if err == nil {
+		e.StmtID = c.cfg.stmtSeq.Add(1)
+	}
+	c.cfg.hooks.after(ctx, e, err)
 	if err != nil {
 		return nil, err
 	}
-	return &customStmt{
-		stmt:   stmt,
-		logger: c.logger,
-		query:  query,
-	}, nil
+
+	return newCustomStmt(stmt, c, e.StmtID, query), nil
 }
 
 func (c *customConn) Close() error {
-	return c.conn.Close()
+	e := c.newEvent(OpClose)
+	ctx := c.cfg.hooks.before(context.Background(), e)
+	err := c.conn.Close()
+	c.cfg.hooks.after(ctx, e, err)
+
+	return err
 }
 
 func (c *customConn) Begin() (driver.Tx, error) {
-	tx, err := c.conn.Begin()
+	e := c.newEvent(OpBegin)
+	ctx := c.cfg.hooks.before(context.Background(), e)
+	var tx driver.Tx
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		tx, err = c.conn.Begin()
+		c.cfg.breaker.done(generation, err)
+	}
+	if err == nil {
+		e.TxID = c.cfg.txSeq.Add(1)
+	}
+	c.cfg.hooks.after(ctx, e, err)
 	if err != nil {
 		return nil, err
 	}
 
-	c.logger.Info("transaction started")
-	return &customTx{
-		tx:     tx,
-		logger: c.logger,
-	}, nil
+	return newCustomTx(context.Background(), tx, c, e), nil
 }
 
 func (c *customConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
-	if connCtx, ok := c.conn.(driver.ConnPrepareContext); ok {
-		stmt, err := connCtx.PrepareContext(ctx, query)
-		if err != nil {
-			return nil, err
-		}
-
-		return &customStmt{
-			stmt:   stmt,
-			logger: c.logger,
-			query:  query,
-		}, nil
+	e := c.newEvent(OpPrepare)
+	e.setQuery(query)
+	ctx = c.cfg.hooks.before(ctx, e)
+	var stmt driver.Stmt
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		stmt, err = c.conn.(driver.ConnPrepareContext).PrepareContext(ctx, c.cfg.commentQuery(ctx, query, true))
+		c.cfg.breaker.done(generation, err)
 	}
-	return c.Prepare(query)
+	if err == nil {
+		e.StmtID = c.cfg.stmtSeq.Add(1)
+	}
+	c.cfg.hooks.after(ctx, e, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCustomStmt(stmt, c, e.StmtID, query), nil
+}
+
+// Exec は sql.Conn の Raw などで直接呼ばれた場合も ExecContext と同じくリトライ・サーキットブレーカー・コメントを適用する
+func (c *customConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.ExecContext(c.baseContext(), query, valuesToNamedValues(args))
 }
 
 func (c *customConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
-	var result driver.Result
-	var err error
-
-	start := time.Now()
-	if execerCtx, ok := c.conn.(driver.ExecerContext); ok {
-		result, err = execerCtx.ExecContext(ctx, query, args)
-		// そのままerrを返してもErrSkipを返せるがその場合無駄にログがでる
-		if err == driver.ErrSkip {
-			c.logger.Warn("original driver does not support ExecerContext")
-			return nil, driver.ErrSkip
+	r := c.newRetrier(ctx, OpExec, query)
+	for {
+		result, err := c.execContext(ctx, query, args, r)
+		if !c.retry(ctx, r, err) {
+			return result, err
 		}
-	} else {
-		c.logger.Warn("original driver does not support ExecerContext")
-		return nil, driver.ErrSkip
 	}
+}
 
-	duration := time.Since(start)
-	if err != nil {
-		c.logger.Error("sql execution failed",
-			slog.String("query", query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		c.logger.Info("sql executed",
-			slog.String("query", query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
+func (c *customConn) execContext(ctx context.Context, query string, args []driver.NamedValue, r *retrier) (driver.Result, error) {
+	e := c.newEvent(OpExec)
+	e.setQuery(query)
+	e.Args = args
+	ctx = c.cfg.hooks.before(ctx, e)
+	query = c.cfg.commentQuery(ctx, query, false)
+	var result driver.Result
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		if execerCtx, ok := c.conn.(driver.ExecerContext); ok {
+			result, err = execerCtx.ExecContext(ctx, query, args)
+		} else {
+			result, err = execLegacy(ctx, args, func(dargs []driver.Value) (driver.Result, error) {
+				return c.conn.(driver.Execer).Exec(query, dargs)
+			})
+		}
+		c.cfg.breaker.done(generation, err)
 	}
+	e.setResult(result)
+	r.check(ctx, e, err)
+	c.finishStatement(ctx, e, err)
 
 	return result, err
 }
 
+// Query は Exec と同じく QueryContext と同じ処理を通す
+func (c *customConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.QueryContext(c.baseContext(), query, valuesToNamedValues(args))
+}
+
 func (c *customConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
-	var rows driver.Rows
-	var err error
-
-	start := time.Now()
-	queryerCtx, ok := c.conn.(driver.QueryerContext)
-	if ok {
-		rows, err = queryerCtx.QueryContext(ctx, query, args)
-		// そのままerrを返してもErrSkipを返せるがその場合無駄にログがでる
-		if err == driver.ErrSkip {
-			c.logger.Warn("original driver does not support QueryerContext")
-			return nil, driver.ErrSkip
+	r := c.newRetrier(ctx, OpQuery, query)
+	for {
+		rows, err := c.queryContext(ctx, query, args, r)
+		if !c.retry(ctx, r, err) {
+			return rows, err
 		}
-	} else {
-		c.logger.Warn("original driver does not support QueryerContext")
-		return nil, driver.ErrSkip
 	}
+}
 
-	duration := time.Since(start)
+func (c *customConn) queryContext(ctx context.Context, query string, args []driver.NamedValue, r *retrier) (driver.Rows, error) {
+	e := c.newEvent(OpQuery)
+	e.setQuery(query)
+	e.Args = args
+	ctx = c.cfg.hooks.before(ctx, e)
+	query = c.cfg.commentQuery(ctx, query, false)
+	var rows driver.Rows
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		if queryerCtx, ok := c.conn.(driver.QueryerContext); ok {
+			rows, err = queryerCtx.QueryContext(ctx, query, args)
+		} else {
+			rows, err = queryLegacy(ctx, args, func(dargs []driver.Value) (driver.Rows, error) {
+				return c.conn.(driver.Queryer).Query(query, dargs)
+			})
+		}
+		c.cfg.breaker.done(generation, err)
+	}
+	r.check(ctx, e, err)
+	c.finishStatement(ctx, e, err)
 	if err != nil {
-		c.logger.Error("sql query failed",
-			slog.String("query", query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		c.logger.Info("sql queried",
-			slog.String("query", query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
+		return nil, err
 	}
 
-	return rows, err
+	return newCustomRows(ctx, c, e, rows), nil
 }
 
 func (c *customConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
-	if connBeginTx, ok := c.conn.(driver.ConnBeginTx); ok {
-		tx, err := connBeginTx.BeginTx(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-		c.logger.Info("transaction started",
-			"isolation", opts.Isolation,
-			"read_only", opts.ReadOnly,
-		)
-		return &customTx{
-			tx:     tx,
-			logger: c.logger,
-		}, nil
+	e := c.newEvent(OpBegin)
+	e.TxOptions = opts
+	hctx := c.cfg.hooks.before(ctx, e)
+	var tx driver.Tx
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		tx, err = c.conn.(driver.ConnBeginTx).BeginTx(hctx, opts)
+		c.cfg.breaker.done(generation, err)
+	}
+	if err == nil {
+		e.TxID = c.cfg.txSeq.Add(1)
 	}
-	return c.Begin()
+	c.cfg.hooks.after(hctx, e, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// トランザクション内の操作には、フックが追加した値を含まない呼び出し元の context を引き継ぐ
+	return newCustomTx(ctx, tx, c, e), nil
 }
 
 func (c *customConn) Ping(ctx context.Context) error {
-	pinger, ok := c.conn.(driver.Pinger)
-	if !ok {
-		// ラップ元が Pinger を実装していない場合、軽量クエリで疎通確認
-		var rows driver.Rows
-		rows, err := c.QueryContext(ctx, "SELECT 1", nil)
-		if err != nil {
+	r := c.newRetrier(ctx, OpPing, "")
+	for {
+		err := c.ping(ctx, r)
+		if !c.retry(ctx, r, err) {
 			return err
 		}
+	}
+}
 
-		return rows.Close()
+func (c *customConn) ping(ctx context.Context, r *retrier) error {
+	e := c.newEvent(OpPing)
+	ctx = c.cfg.hooks.before(ctx, e)
+	generation, err := c.cfg.breaker.allow()
+	if err == nil {
+		err = c.conn.(driver.Pinger).Ping(ctx)
+		c.cfg.breaker.done(generation, err)
 	}
+	r.check(ctx, e, err)
+	c.cfg.hooks.after(ctx, e, err)
 
-	return pinger.Ping(ctx)
+	return err
 }
 
 func (c *customConn) ResetSession(ctx context.Context) error {
-	if resetter, ok := c.conn.(driver.SessionResetter); ok {
-		return resetter.ResetSession(ctx)
-	}
+	e := c.newEvent(OpResetSession)
+	ctx = c.cfg.hooks.before(ctx, e)
+	err := c.conn.(driver.SessionResetter).ResetSession(ctx)
+	c.cfg.hooks.after(ctx, e, err)
 
-	return nil
+	return err
 }
 
 func (c *customConn) IsValid() bool {
-	if validator, ok := c.conn.(driver.Validator); ok {
-		return validator.IsValid()
+	valid := c.conn.(driver.Validator).IsValid()
+	if !valid {
+		// プールに戻されず破棄されるコネクションだけを通知する
+		e := c.newEvent(OpInvalid)
+		ctx := c.cfg.hooks.before(context.Background(), e)
+		c.cfg.hooks.after(ctx, e, nil)
 	}
 
-	// ラップ元が Validator を実装していない場合は有効とみなす
-	return true
+	return valid
 }
 
 func (c *customConn) CheckNamedValue(nv *driver.NamedValue) error {
-	if checker, ok := c.conn.(driver.NamedValueChecker); ok {
-		return checker.CheckNamedValue(nv)
-	}
-
-	return driver.ErrSkip
+	return c.conn.(driver.NamedValueChecker).CheckNamedValue(nv)
 }
@@ -3,46 +3,92 @@ package customdriver
 import (
 	"context"
 	"database/sql/driver"
+	"io"
 	"log/slog"
 )
 
 var (
 	_ driver.Connector = (*CustomConnector)(nil)
+	_ io.Closer        = (*CustomConnector)(nil)
+	_ io.Closer        = (*dsnConnector)(nil)
 )
 
 type CustomConnector struct {
 	connector driver.Connector
 	driver    *CustomDriver
-	logger    *slog.Logger
+	cfg       *config
 }
 
-func NewCustomConnector(connector driver.Connector, logger *slog.Logger) *CustomConnector {
+// logger が nil でない場合は組み込みの LogHook がフックチェーンの先頭に登録される
+func NewCustomConnector(connector driver.Connector, logger *slog.Logger, opts ...Option) *CustomConnector {
+	drv := connector.Driver()
+	cfg := newConfig(drv, logger, opts)
 	return &CustomConnector{
 		connector: connector,
 		driver: &CustomDriver{
-			driver: connector.Driver(),
-			logger: logger,
+			driver: drv,
+			cfg:    cfg,
 		},
-		logger: logger,
+		cfg: cfg,
 	}
 }
 
+// WithRetry が指定されている場合、一時的なエラーで失敗した接続をリトライする。
+// WithBreaker のサーキットブレーカーが開いている場合は接続せずに BreakerOpenError を返す
 func (cc *CustomConnector) Connect(ctx context.Context) (driver.Conn, error) {
-	conn, err := cc.connector.Connect(ctx)
+	return cc.cfg.connect(ctx, cc.connector.Connect)
+}
+
+// connect は open で内部ドライバーのコネクションを開き、フック・リトライ・サーキットブレーカーを適用してラップする。
+// CustomConnector.Connect と CustomDriver.Open で共有する
+func (cfg *config) connect(ctx context.Context, open func(context.Context) (driver.Conn, error)) (driver.Conn, error) {
+	conn, id, err := cfg.dial(ctx, open, cfg.newRetrier(ctx, OpConnect, ""))
 	if err != nil {
 		return nil, err
 	}
+	return newCustomConn(conn, cfg, id, open), nil
+}
 
-	return &customConn{
-		conn:   conn,
-		logger: cc.logger,
-	}, nil
+// dial は open で内部ドライバーのコネクションを開き、フックとサーキットブレーカーを適用してコネクションの ID とともに返す。
+// r が nil の場合はリトライしない
+func (cfg *config) dial(ctx context.Context, open func(context.Context) (driver.Conn, error), r *retrier) (driver.Conn, uint64, error) {
+	for {
+		e := &Event{Op: OpConnect, DBSystem: cfg.system}
+		hctx := cfg.hooks.before(ctx, e)
+		var conn driver.Conn
+		generation, err := cfg.breaker.allow()
+		if err == nil {
+			conn, err = open(hctx)
+			cfg.breaker.done(generation, err)
+		}
+		if err == nil {
+			e.ConnID = cfg.connSeq.Add(1)
+		}
+		r.check(hctx, e, err)
+		cfg.hooks.after(hctx, e, err)
+		if err == nil {
+			return conn, e.ConnID, nil
+		}
+		if !r.sleep(ctx) {
+			return nil, 0, err
+		}
+	}
 }
 
 func (cc *CustomConnector) Driver() driver.Driver {
 	return cc.driver
 }
 
+// Close は sql.DB の Close から呼ばれる。
+// WithLeakDetector が指定されていれば開いたままのオブジェクトを報告し、内部のコネクターが io.Closer であれば閉じる
+func (cc *CustomConnector) Close() error {
+	cc.cfg.leaks.closeCheck()
+	if closer, ok := cc.connector.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // DriverContext 未対応ドライバー向けのフォールバック
 type dsnConnector struct {
 	dsn    string
@@ -56,3 +102,9 @@ func (c *dsnConnector) Connect(ctx context.Context) (driver.Conn, error) {
 func (c *dsnConnector) Driver() driver.Driver {
 	return c.driver
 }
+
+// Close は CustomConnector.Close と同じく、WithLeakDetector が指定されていれば開いたままのオブジェクトを報告する
+func (c *dsnConnector) Close() error {
+	c.driver.cfg.leaks.closeCheck()
+	return nil
+}
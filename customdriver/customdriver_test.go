@@ -1,12 +1,15 @@
 package customdriver
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -22,6 +25,15 @@ var (
 
 	testPgDB *sql.DB
 	rawPgDB  *sql.DB
+
+	// ラップしていない内部コネクターと DSN。個別の Option を試すテストで使う
+	mysqlConnector driver.Connector
+	pgConnector    driver.Connector
+	mysqlDSN       string
+	pgDSN          string
+
+	// testMySQLDB と testPgDB のリークを検出する
+	testLeaks = NewLeakDetector(nil, nil)
 )
 
 func TestMain(m *testing.M) {
@@ -78,7 +90,7 @@ func TestMain(m *testing.M) {
 
 	// --- Wait for MySQL ---
 	mysqlHostPort := mysqlResource.GetHostPort("3306/tcp")
-	mysqlDSN := fmt.Sprintf("root:password@tcp(%s)/testdb?parseTime=true", mysqlHostPort)
+	mysqlDSN = fmt.Sprintf("root:password@tcp(%s)/testdb?parseTime=true", mysqlHostPort)
 
 	if err := pool.Retry(func() error {
 		tmpDB, retryErr := sql.Open("mysql", mysqlDSN)
@@ -95,7 +107,7 @@ func TestMain(m *testing.M) {
 	}
 
 	// --- Wait for PostgreSQL ---
-	pgDSN := fmt.Sprintf("host=localhost port=%s user=testuser password=password dbname=testdb sslmode=disable", pgResource.GetPort("5432/tcp"))
+	pgDSN = fmt.Sprintf("host=localhost port=%s user=testuser password=password dbname=testdb sslmode=disable", pgResource.GetPort("5432/tcp"))
 
 	if err := pool.Retry(func() error {
 		tmpDB, retryErr := sql.Open("postgres", pgDSN)
@@ -165,14 +177,14 @@ func TestMain(m *testing.M) {
 	silentLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
 
 	// MySQL: CustomConnector-based
-	mysqlConnector, err := mysql.MySQLDriver{}.OpenConnector(mysqlDSN)
+	mysqlConnector, err = mysql.MySQLDriver{}.OpenConnector(mysqlDSN)
 	if err != nil {
 		slog.Error("Could not create MySQL connector", "error", err)
 		_ = pool.Purge(mysqlResource)
 		_ = pool.Purge(pgResource)
 		os.Exit(1)
 	}
-	testMySQLDB = sql.OpenDB(NewCustomConnector(mysqlConnector, silentLogger))
+	testMySQLDB = sql.OpenDB(NewCustomConnector(mysqlConnector, silentLogger, WithLeakDetector(testLeaks)))
 
 	// MySQL: raw driver
 	rawMySQLDB, err = sql.Open("mysql", mysqlDSN)
@@ -184,14 +196,14 @@ func TestMain(m *testing.M) {
 	}
 
 	// PostgreSQL: CustomConnector-based
-	pgConnector, err := pq.NewConnector(pgDSN)
+	pgConnector, err = pq.NewConnector(pgDSN)
 	if err != nil {
 		slog.Error("Could not create PostgreSQL connector", "error", err)
 		_ = pool.Purge(mysqlResource)
 		_ = pool.Purge(pgResource)
 		os.Exit(1)
 	}
-	testPgDB = sql.OpenDB(NewCustomConnector(pgConnector, silentLogger))
+	testPgDB = sql.OpenDB(NewCustomConnector(pgConnector, silentLogger, WithLeakDetector(testLeaks)))
 
 	// PostgreSQL: raw driver
 	rawPgDB, err = sql.Open("postgres", pgDSN)
@@ -218,6 +230,51 @@ func TestMain(m *testing.M) {
 // Helper
 // =============================================================================
 
+// fakeClock はテストから進める時計。AfterFunc のタイマーは Advance の中で呼び出される
+type fakeClock struct {
+	now    time.Time
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	at time.Time
+	f  func()
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) {
+	c.timers = append(c.timers, fakeTimer{at: c.now.Add(d), f: f})
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	for {
+		i := slices.IndexFunc(c.timers, func(t fakeTimer) bool { return !t.at.After(c.now) })
+		if i < 0 {
+			return
+		}
+		f := c.timers[i].f
+		c.timers = slices.Delete(c.timers, i, i+1)
+		f()
+	}
+}
+
+// newFakeClockDB は JSON のログを buf に出力し、fake clock を使う DB を返す。
+// setup は logger と clock でテスト対象のコンポーネントを作り、その Option を返す
+func newFakeClockDB(t *testing.T, connector driver.Connector, setup func(logger *slog.Logger, clock *fakeClock) Option) (*sql.DB, *bytes.Buffer, *fakeClock) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	opt := setup(slog.New(slog.NewJSONHandler(&buf, nil)), clock)
+	db := sql.OpenDB(NewCustomConnector(connector, nil, opt))
+	t.Cleanup(func() { db.Close() })
+	return db, &buf, clock
+}
+
 func truncateMySQLUsers(t *testing.T) {
 	t.Helper()
 	if _, err := testMySQLDB.Exec("TRUNCATE TABLE users"); err != nil {
@@ -237,6 +294,7 @@ func truncatePgUsers(t *testing.T) {
 // =============================================================================
 
 func TestMySQL_CustomDriverCRUD(t *testing.T) {
+	t.Cleanup(func() { AssertNoLeaks(t, testLeaks) })
 	t.Run("DirectConn_WithoutContext", testMySQLDirectConnWithoutContext)
 	t.Run("DirectConn_WithContext", testMySQLDirectConnWithContext)
 	t.Run("Stmt_WithoutContext", testMySQLStmtWithoutContext)
@@ -652,6 +710,7 @@ func testMySQLStmtWithContext(t *testing.T) {
 // =============================================================================
 
 func TestPostgreSQL_CustomDriverCRUD(t *testing.T) {
+	t.Cleanup(func() { AssertNoLeaks(t, testLeaks) })
 	t.Run("DirectConn_WithoutContext", testPgDirectConnWithoutContext)
 	t.Run("DirectConn_WithContext", testPgDirectConnWithContext)
 	t.Run("Stmt_WithoutContext", testPgStmtWithoutContext)
@@ -1055,6 +1114,7 @@ func testPgStmtWithContext(t *testing.T) {
 // =============================================================================
 
 func TestMySQL_Transaction(t *testing.T) {
+	t.Cleanup(func() { AssertNoLeaks(t, testLeaks) })
 	truncateMySQLUsers(t)
 	ctx := context.Background()
 
@@ -1112,6 +1172,7 @@ func TestMySQL_Transaction(t *testing.T) {
 }
 
 func TestPostgreSQL_Transaction(t *testing.T) {
+	t.Cleanup(func() { AssertNoLeaks(t, testLeaks) })
 	truncatePgUsers(t)
 	ctx := context.Background()
 
@@ -1,6 +1,7 @@
 package customdriver
 
 import (
+	"context"
 	"database/sql/driver"
 	"log/slog"
 )
@@ -12,26 +13,22 @@ var (
 
 type CustomDriver struct {
 	driver driver.Driver
-	logger *slog.Logger
+	cfg    *config
 }
 
-func NewCustomDriver(drv driver.Driver, logger *slog.Logger) *CustomDriver {
+// logger が nil でない場合は組み込みの LogHook がフックチェーンの先頭に登録される
+func NewCustomDriver(drv driver.Driver, logger *slog.Logger, opts ...Option) *CustomDriver {
 	return &CustomDriver{
 		driver: drv,
-		logger: logger,
+		cfg:    newConfig(drv, logger, opts),
 	}
 }
 
+// Open は CustomConnector.Connect と同じくリトライとサーキットブレーカーを適用する
 func (d *CustomDriver) Open(name string) (driver.Conn, error) {
-	conn, err := d.driver.Open(name)
-	if err != nil {
-		return nil, err
-	}
-
-	return &customConn{
-		conn:   conn,
-		logger: d.logger,
-	}, nil
+	return d.cfg.connect(context.Background(), func(context.Context) (driver.Conn, error) {
+		return d.driver.Open(name)
+	})
 }
 
 // 内部ドライバーが DriverContext をサポートする場合はその OpenConnector に委譲し、
@@ -45,7 +42,7 @@ func (d *CustomDriver) OpenConnector(name string) (driver.Connector, error) {
 		return &CustomConnector{
 			connector: connector,
 			driver:    d,
-			logger:    d.logger,
+			cfg:       d.cfg,
 		}, nil
 	}
 
@@ -0,0 +1,228 @@
+package customdriver
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Fingerprint はクエリを正規化し、値だけが異なるクエリを同じ文字列にまとめる。
+//
+//   - コメントを取り除き、空白を 1 つにまとめる
+//   - 文字列・数値のリテラルと、? / $n のプレースホルダーを ? にする
+//   - IN (?, ?, ...) を IN (...) にまとめる
+//   - クォートされていない識別子とキーワードを小文字にする
+//
+// MySQL と PostgreSQL の構文を対象とし、クエリを解析はしないため不正なクエリでもエラーにはならない。
+// ダブルクォートは識別子として扱う。MySQL の方言で正規化する場合は FingerprintFor を使う
+func Fingerprint(query string) string {
+	return FingerprintFor("", query)
+}
+
+// FingerprintFor は dbSystem (Event.DBSystem の値) の方言で query を正規化する。
+// mysql の場合は # の行コメントを取り除き、ダブルクォートを文字列のリテラルとして扱う (ANSI_QUOTES が無効な既定の動作)
+func FingerprintFor(dbSystem, query string) string {
+	tokens := tokenize(query, dbSystem == "mysql")
+	tokens = foldInLists(tokens)
+
+	var b strings.Builder
+	b.Grow(len(query))
+	for i, tok := range tokens {
+		if i > 0 && needsSpace(tokens[i-1], tok) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(tok)
+	}
+	return b.String()
+}
+
+// fingerprintCache はクエリからフィンガープリントへのキャッシュ。
+// 同じクエリ文字列は繰り返し実行されるため、毎回正規化しないようにする
+type fingerprintCache struct {
+	size int
+
+	mu sync.Mutex
+	m  map[fingerprintKey]string
+}
+
+// fingerprintKey は方言ごとにキャッシュを分ける
+type fingerprintKey struct {
+	dbSystem string
+	query    string
+}
+
+func newFingerprintCache(size int) *fingerprintCache {
+	return &fingerprintCache{size: size, m: map[fingerprintKey]string{}}
+}
+
+// get は e のクエリを e.DBSystem の方言で正規化したフィンガープリントを返す
+func (c *fingerprintCache) get(e *Event) string {
+	key := fingerprintKey{dbSystem: e.DBSystem, query: e.Query}
+	c.mu.Lock()
+	fp, ok := c.m[key]
+	c.mu.Unlock()
+	if ok {
+		return fp
+	}
+
+	fp = FingerprintFor(e.DBSystem, e.Query)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// キャッシュが増え続けないよう、上限に達したら作り直す
+	if len(c.m) >= c.size {
+		clear(c.m)
+	}
+	c.m[key] = fp
+	return fp
+}
+
+// tokenize はクエリを正規化したトークンに分割する。mysql が true の場合は MySQL の方言として扱う
+func tokenize(query string, mysql bool) []string {
+	var tokens []string
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case strings.HasPrefix(query[i:], "--") || (mysql && c == '#'):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				return tokens
+			}
+			i += end + 1
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return tokens
+			}
+			i += 2 + end + 2
+		case c == '\'' || (mysql && c == '"'):
+			i = skipQuoted(query, i, c, true)
+			tokens = append(tokens, "?")
+		case c == '"' || c == '`':
+			// クォートされた識別子はそのまま残す
+			end := skipQuoted(query, i, c, false)
+			tokens = append(tokens, query[i:end])
+			i = end
+		case c == '?':
+			i++
+			tokens = append(tokens, "?")
+		case c == '$':
+			// $1 のプレースホルダーと $tag$...$tag$ の文字列
+			j := i + 1
+			for j < len(query) && isDigit(query[j]) {
+				j++
+			}
+			if j > i+1 {
+				tokens = append(tokens, "?")
+				i = j
+				continue
+			}
+			for j < len(query) && isIdentByte(query[j]) {
+				j++
+			}
+			if j < len(query) && query[j] == '$' {
+				tag := query[i : j+1]
+				if end := strings.Index(query[j+1:], tag); end >= 0 {
+					tokens = append(tokens, "?")
+					i = j + 1 + end + len(tag)
+					continue
+				}
+			}
+			tokens = append(tokens, "$")
+			i++
+		case isDigit(c) || (c == '.' && i+1 < len(query) && isDigit(query[i+1])):
+			j := i
+			for j < len(query) && (isIdentByte(query[j]) || query[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, "?")
+			i = j
+		case isIdentByte(c) || c >= utf8.RuneSelf:
+			j := i
+			for j < len(query) && (isIdentByte(query[j]) || query[j] >= utf8.RuneSelf || query[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, strings.ToLower(query[i:j]))
+			i = j
+		case strings.ContainsRune("(),;.", rune(c)):
+			tokens = append(tokens, query[i:i+1])
+			i++
+		default:
+			// 演算子は連続する記号をまとめて 1 つのトークンにする
+			j := i + 1
+			for j < len(query) && strings.IndexByte("<>=!|&+-*/%^~:@#", query[j]) >= 0 &&
+				!strings.HasPrefix(query[j:], "--") && !strings.HasPrefix(query[j:], "/*") && !(mysql && query[j] == '#') {
+				j++
+			}
+			tokens = append(tokens, query[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+// skipQuoted は start の引用符で始まる文字列の終わりの次の位置を返す。
+// 引用符を 2 つ重ねたエスケープと、literal が true の場合はバックスラッシュによるエスケープを考慮する
+func skipQuoted(query string, start int, quote byte, literal bool) int {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if literal {
+				i++
+			}
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+// foldInLists は in ( ? , ? , ... ) を in ( ... ) にまとめる
+func foldInLists(tokens []string) []string {
+	out := tokens[:0:0]
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if tokens[i] != "in" || i+2 >= len(tokens) || tokens[i+1] != "(" {
+			continue
+		}
+		j := i + 2
+		for j < len(tokens) && tokens[j] == "?" {
+			if j+1 < len(tokens) && tokens[j+1] == "," {
+				j += 2
+				continue
+			}
+			j++
+			break
+		}
+		if j > i+2 && j < len(tokens) && tokens[j] == ")" && tokens[j-1] == "?" {
+			out = append(out, "(", "...", ")")
+			i = j
+		}
+	}
+	return out
+}
+
+// needsSpace はトークンの間に空白を入れるかを返す。
+// 元のクエリの空白の有無によらず同じ文字列になるよう、括弧・カンマ・ドットの前後以外は常に空白を入れる
+func needsSpace(prev, cur string) bool {
+	switch {
+	case prev == "(" || prev == ".":
+		return false
+	case cur == ")" || cur == "," || cur == ";" || cur == ".":
+		return false
+	}
+	return true
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_'
+}
@@ -0,0 +1,95 @@
+package customdriver
+
+import (
+	"testing"
+)
+
+// =============================================================================
+// Fingerprint Tests
+// =============================================================================
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		name     string
+		dbSystem string
+		query    string
+		want     string
+	}{
+		{
+			name:  "MySQL placeholders",
+			query: "SELECT id, name FROM users WHERE id = ?",
+			want:  "select id, name from users where id = ?",
+		},
+		{
+			name:  "PostgreSQL placeholders",
+			query: "SELECT id, name FROM users WHERE id = $1 AND name = $2",
+			want:  "select id, name from users where id = ? and name = ?",
+		},
+		{
+			name:  "literals",
+			query: "SELECT * FROM users WHERE name = 'it''s' AND id > 42 AND score < 1.5e3",
+			want:  "select * from users where name = ? and id > ? and score < ?",
+		},
+		{
+			name:  "MySQL escaped string",
+			query: `SELECT * FROM users WHERE name = 'a\'b'`,
+			want:  "select * from users where name = ?",
+		},
+		{
+			name:  "PostgreSQL dollar quoted string and cast",
+			query: "SELECT $tag$it's$tag$::text, $$x$$",
+			want:  "select ? :: text, ?",
+		},
+		{
+			name:  "IN list",
+			query: "SELECT * FROM users WHERE id IN (1, 2, 3) OR id IN ($1,$2)",
+			want:  "select * from users where id in (...) or id in (...)",
+		},
+		{
+			name:  "IN subquery is kept",
+			query: "SELECT * FROM users WHERE id IN (SELECT user_id FROM orders)",
+			want:  "select * from users where id in (select user_id from orders)",
+		},
+		{
+			name:  "comments and whitespace",
+			query: "/* list */ SELECT\n\tid -- primary key\nFROM   users /*application='api'*/",
+			want:  "select id from users",
+		},
+		{
+			name:  "quoted identifiers",
+			query: "SELECT \"Name\", `order` FROM t",
+			want:  "select \"Name\", `order` from t",
+		},
+		{
+			name:  "insert",
+			query: "INSERT INTO users(name) VALUES ('alice')",
+			want:  "insert into users (name) values (?)",
+		},
+		{
+			name:     "MySQL hash comment",
+			dbSystem: "mysql",
+			query:    "SELECT id # primary key\nFROM users WHERE id=# inline\n1",
+			want:     "select id from users where id = ?",
+		},
+		{
+			name:     "MySQL double quoted string",
+			dbSystem: "mysql",
+			query:    `SELECT * FROM users WHERE name = "it""s" OR name = "a\"b"`,
+			want:     "select * from users where name = ? or name = ?",
+		},
+		{
+			name:     "PostgreSQL double quoted identifier",
+			dbSystem: "postgresql",
+			query:    `SELECT "Name" FROM t WHERE a #> '{b}'`,
+			want:     `select "Name" from t where a #> ?`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FingerprintFor(tt.dbSystem, tt.query); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+)
+
+// Op はフックに通知されるドライバー操作の種類
+type Op string
+
+const (
+	OpConnect      Op = "connect"
+	OpPrepare      Op = "prepare"
+	OpExec         Op = "exec"
+	OpQuery        Op = "query"
+	OpBegin        Op = "begin"
+	OpCommit       Op = "commit"
+	OpRollback     Op = "rollback"
+	OpPing         Op = "ping"
+	OpResetSession Op = "reset_session"
+	// OpRowsClose は Query が返した行の読み出しを終えて Close したときに通知される
+	OpRowsClose Op = "rows_close"
+	// OpClose はコネクションを閉じたときに通知される
+	OpClose Op = "close"
+	// OpInvalid は IsValid がコネクションを無効と判定したときに通知される
+	OpInvalid Op = "invalid"
+)
+
+// Event は 1 回のドライバー操作を表す。
+// Before と After には同じ *Event が渡されるため、フックは After で結果を参照できる。
+type Event struct {
+	Op Op
+	// 内部ドライバーから推定したデータベースの種類 (mysql, postgresql)。不明な場合は空文字列
+	DBSystem string
+	// コネクター (またはドライバー) ごとに 1 から振られる識別子。該当しない場合は 0。
+	// OpConnect では接続に成功した場合のみ After の前に設定される。
+	// OpPrepare の StmtID と OpBegin の TxID も同様に成功した場合のみ After の前に設定され、番号は欠番にならない
+	ConnID uint64
+	StmtID uint64
+	TxID   uint64
+
+	Query string
+	// sqlc のヘッダー (-- name: GetUserByName :one) の名前、または WithQueryName で付けられた名前。
+	// 両方ある場合は sqlc のヘッダーを優先する。名前がない場合は空文字列
+	QueryName string
+	// sqlc のヘッダーのコマンド (one, many, exec, execrows など)。sqlc 以外のクエリでは空文字列
+	Command string
+	Args    []driver.NamedValue
+	// プリペアドステートメント経由の Exec / Query の場合 true
+	Stmt bool
+	// OpBegin のときのみ設定される
+	TxOptions driver.TxOptions
+
+	// OpRowsClose のときのみ設定される。
+	// OpRowsClose の Start / Duration はクエリ開始から Close までの全体を表す
+	RowsRead int64
+	FirstRow time.Duration
+	// WithOneRowCheck が指定され、Command が one のクエリが 2 行以上を返した場合 true
+	MultipleRows bool
+
+	// OpExec が成功したときのみ設定される。内部ドライバーが対応していない場合は 0。
+	// LastInsertID は MySQL の AUTO_INCREMENT など、内部ドライバーが返した場合のみ設定される
+	RowsAffected int64
+	LastInsertID int64
+
+	// WithSlowQuery で遅いクエリと判定された OpExec / OpRowsClose のときのみ設定される。
+	// SlowThreshold は超えたしきい値、Plan は EXPLAIN の結果 (JSON)、PlanErr は EXPLAIN の失敗。
+	// Plan / PlanErr は PlanHook に渡される Event にのみ設定される
+	SlowThreshold time.Duration
+	Plan          string
+	PlanErr       error
+
+	// OpCommit / OpRollback のときのみ設定される
+	Tx *TxSummary
+
+	// WithCaller が指定された場合のみ設定される。OpRowsClose にはクエリの値が引き継がれる
+	Caller Caller
+
+	// WithRetry でリトライの対象となった操作のみ設定される。Attempt は 1 から数えた試行回数。
+	// RetryBackoff が 0 より大きい場合、失敗した操作をこの時間の後にリトライする
+	Attempt      int
+	RetryBackoff time.Duration
+
+	// 以下は内部ドライバーの呼び出し後に設定される
+	Start    time.Time
+	Duration time.Duration
+	Err      error
+	// Err を Classify で分類した結果。Err が nil の場合は空文字列
+	Category Category
+
+	// EXPLAIN 用に内部ドライバーの新しいコネクションを開く。WithSlowQuery で Explain が有効な場合のみ設定される
+	connect func(context.Context) (driver.Conn, error)
+}
+
+// Hook はドライバー操作の前後に呼び出されるコールバック。
+//
+// Before が返した context は内部ドライバーの呼び出しと After に渡される。
+// context を持たない操作 (Exec, Query, Begin など) では context.Background() が渡される。
+// ただしトランザクション中の操作と Commit / Rollback には BeginTx の context (キャンセルは除く) が、
+// OpRowsClose にはクエリの Before が返した context が渡される。
+// 内部ドライバーが driver.ErrSkip を返した場合、database/sql は別の経路で実行し直すため After は呼ばれない。
+type Hook interface {
+	Before(ctx context.Context, e *Event) context.Context
+	After(ctx context.Context, e *Event)
+}
+
+// SkipHook は After の代わりに driver.ErrSkip の通知を受け取るフック。
+// Before で確保したものを解放する必要がある場合に実装する。e.Err には driver.ErrSkip が設定される
+type SkipHook interface {
+	Skipped(ctx context.Context, e *Event)
+}
+
+// PlanHook は WithSlowQuery の EXPLAIN の結果を受け取るフック。
+// EXPLAIN は遅いクエリの After の後にバックグラウンドで実行され、e には元の操作の Event の
+// コピーに Plan または PlanErr を設定したものが渡される
+type PlanHook interface {
+	Planned(ctx context.Context, e *Event)
+}
+
+// hooks は登録順に Before を、逆順に After (driver.ErrSkip の場合は Skipped) を呼び出す
+type hooks []Hook
+
+func (hs hooks) before(ctx context.Context, e *Event) context.Context {
+	if e.QueryName == "" {
+		e.QueryName = QueryNameFromContext(ctx)
+	}
+	for _, h := range hs {
+		ctx = h.Before(ctx, e)
+	}
+	if e.Start.IsZero() {
+		e.Start = time.Now()
+	}
+	return ctx
+}
+
+func (hs hooks) after(ctx context.Context, e *Event, err error) {
+	e.Duration = time.Since(e.Start)
+	e.Err = err
+	if err == driver.ErrSkip {
+		for i := len(hs) - 1; i >= 0; i-- {
+			if h, ok := hs[i].(SkipHook); ok {
+				h.Skipped(ctx, e)
+			}
+		}
+		return
+	}
+	if err != nil {
+		e.Category = Classify(err)
+	}
+	for i := len(hs) - 1; i >= 0; i-- {
+		hs[i].After(ctx, e)
+	}
+}
+
+// planned は Before / After とは対応しないため、登録順に Planned を呼び出す
+func (hs hooks) planned(ctx context.Context, e *Event) {
+	for _, h := range hs {
+		if h, ok := h.(PlanHook); ok {
+			h.Planned(ctx, e)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// recordingHook は呼び出された操作を記録するテスト用のフック
+type recordingHook struct {
+	name string
+
+	mu     sync.Mutex
+	calls  *[]string
+	events []Event
+}
+
+func (h *recordingHook) Before(ctx context.Context, e *Event) context.Context {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.calls != nil {
+		*h.calls = append(*h.calls, h.name+":before:"+string(e.Op))
+	}
+	return ctx
+}
+
+func (h *recordingHook) After(ctx context.Context, e *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.calls != nil {
+		*h.calls = append(*h.calls, h.name+":after:"+string(e.Op))
+	}
+	h.events = append(h.events, *e)
+}
+
+func (h *recordingHook) ops() []Op {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ops := make([]Op, 0, len(h.events))
+	for _, e := range h.events {
+		ops = append(ops, e.Op)
+	}
+	return ops
+}
+
+func (h *recordingHook) find(op Op) (Event, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, e := range h.events {
+		if e.Op == op {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+// skipRecordingHook は Skipped の呼び出しも記録する
+type skipRecordingHook struct {
+	recordingHook
+	skipped []Op
+}
+
+func (h *skipRecordingHook) Skipped(ctx context.Context, e *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.skipped = append(h.skipped, e.Op)
+}
+
+// failOnceConn は最初の Prepare と Begin だけが失敗する txConn
+type failOnceConn struct {
+	txConn
+	prepared, begun bool
+}
+
+func (c *failOnceConn) Prepare(query string) (driver.Stmt, error) {
+	if !c.prepared {
+		c.prepared = true
+		return nil, errors.New("prepare failed")
+	}
+	return c.txConn.Prepare(query)
+}
+
+func (c *failOnceConn) Begin() (driver.Tx, error) {
+	if !c.begun {
+		c.begun = true
+		return nil, errors.New("begin failed")
+	}
+	return c.txConn.Begin()
+}
+
+// =============================================================================
+// Hook Tests
+// =============================================================================
+
+func TestHooks_ErrSkip(t *testing.T) {
+	plain := &recordingHook{}
+	skip := &skipRecordingHook{}
+	inflight := NewInFlightHook(nil)
+	metrics := NewMetricsHook(nil)
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &skipConn{}}, nil, WithHooks(plain, skip, inflight, metrics)))
+	defer db.Close()
+
+	// skipConn の ExecContext は driver.ErrSkip を返し、database/sql はプリペアドステートメントで実行し直す
+	if _, err := db.Exec("DELETE FROM users WHERE id = ?", 1); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	for _, e := range plain.events {
+		if e.Err != nil {
+			t.Errorf("expected After not to receive driver.ErrSkip, got %+v", e)
+		}
+	}
+	if exec, ok := plain.find(OpExec); !ok || !exec.Stmt {
+		t.Errorf("expected only the prepared exec, got %v", plain.ops())
+	}
+	if !slices.Equal(skip.skipped, []Op{OpExec}) {
+		t.Errorf("expected Skipped for exec, got %v", skip.skipped)
+	}
+	if ops := inflight.Operations(); len(ops) != 0 {
+		t.Errorf("expected skipped exec to be removed, got %+v", ops)
+	}
+	got := scrape(t, metrics)
+	if got[`customdriver_operations_total{op="exec"}`] != "1" {
+		t.Errorf("expected 1 exec, got %q", got[`customdriver_operations_total{op="exec"}`])
+	}
+	if got["customdriver_in_flight_queries"] != "0" {
+		t.Errorf("expected no in-flight queries after skipped exec, got %q", got["customdriver_in_flight_queries"])
+	}
+}
+
+func TestHooks_IDsOnlyOnSuccess(t *testing.T) {
+	hook := &recordingHook{}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &failOnceConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+
+	for range 2 {
+		if stmt, err := db.Prepare("SELECT id, name FROM users"); err == nil {
+			stmt.Close()
+		}
+		if tx, err := db.Begin(); err == nil {
+			tx.Rollback()
+		}
+	}
+
+	// 失敗した Prepare / Begin には番号を振らず、成功したものが 1 から始まる
+	var stmtIDs, txIDs []uint64
+	for _, e := range hook.events {
+		switch e.Op {
+		case OpPrepare:
+			stmtIDs = append(stmtIDs, e.StmtID)
+		case OpBegin:
+			txIDs = append(txIDs, e.TxID)
+		}
+	}
+	if !slices.Equal(stmtIDs, []uint64{0, 1}) || !slices.Equal(txIDs, []uint64{0, 1}) {
+		t.Errorf("expected ids [0 1], got stmt %v tx %v", stmtIDs, txIDs)
+	}
+}
+
+func TestMySQL_HookChain(t *testing.T) {
+	truncateMySQLUsers(t)
+	ctx := context.Background()
+
+	var calls []string
+	first := &recordingHook{name: "first", calls: &calls}
+	second := &recordingHook{name: "second", calls: &calls}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(first, second)))
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "hook-user"); err != nil {
+		tx.Rollback()
+		t.Fatalf("INSERT in tx failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	want := []string{
+		"first:before:connect", "second:before:connect", "second:after:connect", "first:after:connect",
+		"first:before:begin", "second:before:begin", "second:after:begin", "first:after:begin",
+	}
+	if len(calls) < len(want) || !slices.Equal(calls[:len(want)], want) {
+		t.Errorf("unexpected hook call order: %v", calls)
+	}
+
+	ops := second.ops()
+	for _, op := range []Op{OpConnect, OpBegin, OpCommit} {
+		if !slices.Contains(ops, op) {
+			t.Errorf("expected %s event, got %v", op, ops)
+		}
+	}
+	if !slices.Contains(ops, OpExec) && !slices.Contains(ops, OpPrepare) {
+		t.Errorf("expected exec or prepare event, got %v", ops)
+	}
+}
+
+func TestPostgreSQL_HookChain(t *testing.T) {
+	truncatePgUsers(t)
+	ctx := context.Background()
+
+	hook := &recordingHook{name: "pg"}
+	db := sql.OpenDB(NewCustomConnector(pgConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES ($1)", "hook-user"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+
+	e, ok := hook.find(OpExec)
+	if !ok {
+		t.Fatalf("expected exec event, got %v", hook.ops())
+	}
+	if e.Query != "INSERT INTO users (name) VALUES ($1)" {
+		t.Errorf("unexpected query %q", e.Query)
+	}
+	if e.Err != nil {
+		t.Errorf("unexpected error %v", e.Err)
+	}
+	if _, ok := hook.find(OpPing); !ok {
+		t.Errorf("expected ping event, got %v", hook.ops())
+	}
+}
+
+func TestMySQL_EventIDs(t *testing.T) {
+	truncateMySQLUsers(t)
+	ctx := context.Background()
+
+	hook := &recordingHook{name: "ids"}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	stmt, err := tx.PrepareContext(ctx, "INSERT INTO users (name) VALUES (?)")
+	if err != nil {
+		tx.Rollback()
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if _, err := stmt.ExecContext(ctx, "id-user"); err != nil {
+		tx.Rollback()
+		t.Fatalf("stmt Exec failed: %v", err)
+	}
+	stmt.Close()
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	db.Close()
+
+	connect, _ := hook.find(OpConnect)
+	begin, _ := hook.find(OpBegin)
+	prepare, _ := hook.find(OpPrepare)
+	exec, _ := hook.find(OpExec)
+	commit, _ := hook.find(OpCommit)
+	closed, ok := hook.find(OpClose)
+	if !ok {
+		t.Fatalf("expected close event, got %v", hook.ops())
+	}
+
+	if connect.ConnID == 0 {
+		t.Fatalf("expected connection id to be assigned")
+	}
+	for _, e := range []Event{begin, prepare, exec, commit, closed} {
+		if e.ConnID != connect.ConnID {
+			t.Errorf("%s: expected conn id %d, got %d", e.Op, connect.ConnID, e.ConnID)
+		}
+	}
+	if begin.TxID == 0 || exec.TxID != begin.TxID || commit.TxID != begin.TxID {
+		t.Errorf("unexpected tx ids: begin=%d exec=%d commit=%d", begin.TxID, exec.TxID, commit.TxID)
+	}
+	if prepare.StmtID == 0 || exec.StmtID != prepare.StmtID {
+		t.Errorf("unexpected stmt ids: prepare=%d exec=%d", prepare.StmtID, exec.StmtID)
+	}
+	if closed.TxID != 0 {
+		t.Errorf("expected no tx id after commit, got %d", closed.TxID)
+	}
+}
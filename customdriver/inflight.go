@@ -0,0 +1,272 @@
+package customdriver
+
+import (
+	"cmp"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ Hook         = (*InFlightHook)(nil)
+	_ SkipHook     = (*InFlightHook)(nil)
+	_ http.Handler = (*InFlightHook)(nil)
+)
+
+// InFlightOptions は InFlightHook が公開する情報を調整する
+type InFlightOptions struct {
+	// true の場合は引数を全て伏せる
+	RedactArgs bool
+	// 引数の出力方法。LogOptions.Args と同じ
+	Args ArgPolicy
+}
+
+// InFlightOperation は実行中の 1 つの操作
+type InFlightOperation struct {
+	ID        uint64 `json:"id"`
+	Op        Op     `json:"op"`
+	ConnID    uint64 `json:"conn_id"`
+	TxID      uint64 `json:"tx_id,omitempty"`
+	Query     string `json:"query,omitempty"`
+	QueryName string `json:"query_name,omitempty"`
+	Args      any    `json:"args,omitempty"`
+	// WithAttrs で context に付けた属性
+	Attrs  map[string]string `json:"attrs,omitempty"`
+	Caller string            `json:"caller,omitempty"`
+	Start  time.Time         `json:"start"`
+	// 取得した時点での経過時間 (ナノ秒)
+	Elapsed time.Duration `json:"elapsed"`
+	// Cancel で中断できる場合 true。Commit / Rollback は内部ドライバーに context を渡さないため中断できない
+	Cancelable bool `json:"cancelable"`
+}
+
+// InFlightHook は実行中の Exec / Query / Commit / Rollback を記録するフック。
+// Query は Rows の Close まで実行中とみなす。
+//
+// Exec / Query には Before で中断用の context を渡し、Cancel でその context をキャンセルする。
+// ServeHTTP は GET で一覧を JSON (Accept が text/html の場合または ?format=html の場合は HTML) で返し、
+// POST の id パラメーターで指定された操作を中断する。
+// クエリと引数を公開するため、内部向けのポートでのみ公開すること
+type InFlightHook struct {
+	opts InFlightOptions
+
+	mu  sync.Mutex
+	seq uint64
+	ops map[uint64]*inFlightEntry
+}
+
+type inFlightEntry struct {
+	op     InFlightOperation
+	cancel context.CancelFunc
+}
+
+type inFlightKey struct {
+	h *InFlightHook
+}
+
+// opts が nil の場合は既定値を使う
+func NewInFlightHook(opts *InFlightOptions) *InFlightHook {
+	h := &InFlightHook{
+		ops: map[uint64]*inFlightEntry{},
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+func (h *InFlightHook) Before(ctx context.Context, e *Event) context.Context {
+	var cancelable bool
+	switch e.Op {
+	case OpExec, OpQuery:
+		cancelable = true
+	case OpCommit, OpRollback:
+	default:
+		return ctx
+	}
+
+	op := InFlightOperation{
+		Op:         e.Op,
+		ConnID:     e.ConnID,
+		TxID:       e.TxID,
+		Query:      e.Query,
+		QueryName:  e.QueryName,
+		Caller:     e.Caller.String(),
+		Start:      time.Now(),
+		Cancelable: cancelable,
+	}
+	if e.Op == OpExec || e.Op == OpQuery {
+		op.Args = argsValue(e.Args, e.QueryName, h.opts.RedactArgs, &h.opts.Args)
+	}
+	if attrs := AttrsFromContext(ctx); len(attrs) > 0 {
+		op.Attrs = make(map[string]string, len(attrs))
+		for _, a := range attrs {
+			op.Attrs[a.Key] = a.Value.String()
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.seq++
+	op.ID = h.seq
+	h.ops[op.ID] = &inFlightEntry{op: op, cancel: cancel}
+	return context.WithValue(ctx, inFlightKey{h}, op.ID)
+}
+
+func (h *InFlightHook) After(ctx context.Context, e *Event) {
+	switch e.Op {
+	case OpQuery:
+		// 成功したクエリは Rows の Close まで実行中とみなす
+		if e.Err == nil {
+			return
+		}
+	case OpExec, OpCommit, OpRollback, OpRowsClose:
+	default:
+		return
+	}
+	h.remove(ctx)
+}
+
+// Skipped は driver.ErrSkip で実行されなかった Exec / Query を取り除く
+func (h *InFlightHook) Skipped(ctx context.Context, e *Event) {
+	h.remove(ctx)
+}
+
+// remove は Before で ctx に記録した操作を取り除き、その context を解放する
+func (h *InFlightHook) remove(ctx context.Context) {
+	id, ok := ctx.Value(inFlightKey{h}).(uint64)
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+	entry, ok := h.ops[id]
+	delete(h.ops, id)
+	h.mu.Unlock()
+	if ok {
+		entry.cancel()
+	}
+}
+
+// Operations は実行中の操作を開始の早い順に返す
+func (h *InFlightHook) Operations() []InFlightOperation {
+	now := time.Now()
+	h.mu.Lock()
+	result := make([]InFlightOperation, 0, len(h.ops))
+	for _, entry := range h.ops {
+		op := entry.op
+		op.Elapsed = now.Sub(op.Start)
+		result = append(result, op)
+	}
+	h.mu.Unlock()
+
+	slices.SortFunc(result, func(a, b InFlightOperation) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+// Cancel は id の操作の context をキャンセルする。該当する中断可能な操作がない場合は false を返す。
+// 中断された操作は内部ドライバーから context.Canceled などのエラーを返す
+func (h *InFlightHook) Cancel(id uint64) bool {
+	h.mu.Lock()
+	entry, ok := h.ops[id]
+	h.mu.Unlock()
+	if !ok || !entry.op.Cancelable {
+		return false
+	}
+	entry.cancel()
+	return true
+}
+
+func (h *InFlightHook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost:
+		id, err := strconv.ParseUint(r.FormValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+		if !h.Cancel(id) {
+			http.Error(w, "operation not found or not cancelable", http.StatusNotFound)
+			return
+		}
+		// HTML のフォームから送られた場合は一覧に戻す
+		if r.FormValue("format") == "html" {
+			http.Redirect(w, r, r.URL.Path+"?format=html", http.StatusSeeOther)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ops := h.Operations()
+	if r.FormValue("format") == "html" || strings.Contains(r.Header.Get("Accept"), "text/html") {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := inFlightTemplate.Execute(w, ops); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ops); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// argsString は HTML に表示するための引数の文字列表現
+func argsString(args any) string {
+	nvs, ok := args.([]driver.NamedValue)
+	if !ok {
+		if args == nil {
+			return ""
+		}
+		b, _ := json.Marshal(args)
+		return string(b)
+	}
+	values := make([]string, len(nvs))
+	for i, nv := range nvs {
+		b, err := json.Marshal(nv.Value)
+		if err != nil {
+			b = []byte(strconv.Quote(err.Error()))
+		}
+		values[i] = string(b)
+		if nv.Name != "" {
+			values[i] = nv.Name + "=" + values[i]
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+var inFlightTemplate = template.Must(template.New("inflight").Funcs(template.FuncMap{"args": argsString}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>in-flight operations</title></head>
+<body>
+<h1>in-flight operations ({{len .}})</h1>
+<table border="1">
+<tr><th>id</th><th>op</th><th>conn</th><th>tx</th><th>elapsed</th><th>query</th><th>args</th><th>attrs</th><th>caller</th><th></th></tr>
+{{range .}}<tr>
+<td>{{.ID}}</td><td>{{.Op}}</td><td>{{.ConnID}}</td><td>{{if .TxID}}{{.TxID}}{{end}}</td><td>{{.Elapsed}}</td>
+<td>{{if .QueryName}}<b>{{.QueryName}}</b><br>{{end}}<pre>{{.Query}}</pre></td>
+<td>{{args .Args}}</td>
+<td>{{range $k, $v := .Attrs}}{{$k}}={{$v}}<br>{{end}}</td>
+<td>{{.Caller}}</td>
+<td>{{if .Cancelable}}<form method="post"><input type="hidden" name="id" value="{{.ID}}"><input type="hidden" name="format" value="html"><button>cancel</button></form>{{end}}</td>
+</tr>
+{{end}}</table>
+</body>
+</html>
+`))
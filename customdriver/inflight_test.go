@@ -0,0 +1,198 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingConn は ExecContext が context のキャンセルまで戻らないコネクション
+type blockingConn struct {
+	legacyConn
+}
+
+func (c *blockingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// waitInFlight は実行中の操作が n 件になるまで待ち、その一覧を返す
+func waitInFlight(t *testing.T, srv *httptest.Server, n int) []InFlightOperation {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		res, err := srv.Client().Get(srv.URL)
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		var ops []InFlightOperation
+		err = json.NewDecoder(res.Body).Decode(&ops)
+		res.Body.Close()
+		if err != nil {
+			t.Fatalf("failed to decode operations: %v", err)
+		}
+		if len(ops) == n {
+			return ops
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d in-flight operations, got %+v", n, ops)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func cancelInFlight(t *testing.T, srv *httptest.Server, id uint64) int {
+	t.Helper()
+
+	res, err := srv.Client().PostForm(srv.URL, url.Values{"id": {strconv.FormatUint(id, 10)}})
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	res.Body.Close()
+	return res.StatusCode
+}
+
+// =============================================================================
+// In-Flight Registry Tests
+// =============================================================================
+
+func TestInFlightHook_Cancel(t *testing.T) {
+	hook := NewInFlightHook(&InFlightOptions{Args: ArgPolicy{Names: []string{"password"}}})
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &blockingConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	ctx := WithAttrs(context.Background(), slog.String("request_id", "req-1"))
+	errc := make(chan error, 1)
+	go func() {
+		_, err := db.ExecContext(ctx, "UPDATE users SET password = :password", sql.Named("password", "secret"))
+		errc <- err
+	}()
+
+	ops := waitInFlight(t, srv, 1)
+	op := ops[0]
+	if op.Op != OpExec || op.Query != "UPDATE users SET password = :password" || !op.Cancelable {
+		t.Errorf("unexpected operation: %+v", op)
+	}
+	if op.Attrs["request_id"] != "req-1" {
+		t.Errorf("expected request_id attr, got %v", op.Attrs)
+	}
+	args, _ := json.Marshal(op.Args)
+	if strings.Contains(string(args), "secret") || !strings.Contains(string(args), redacted) {
+		t.Errorf("expected redacted args, got %s", args)
+	}
+
+	// HTML でも一覧を返す
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	req.Header.Set("Accept", "text/html")
+	res, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if !strings.Contains(res.Header.Get("Content-Type"), "text/html") || !strings.Contains(string(body), "UPDATE users SET password") {
+		t.Errorf("unexpected HTML response: %s", body)
+	}
+
+	if code := cancelInFlight(t, srv, op.ID+100); code != http.StatusNotFound {
+		t.Errorf("expected 404 for unknown id, got %d", code)
+	}
+	if code := cancelInFlight(t, srv, op.ID); code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", code)
+	}
+	select {
+	case err := <-errc:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("ExecContext was not canceled")
+	}
+	waitInFlight(t, srv, 0)
+}
+
+func TestInFlightHook_QueryUntilRowsClose(t *testing.T) {
+	hook := NewInFlightHook(nil)
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	if ops := hook.Operations(); len(ops) != 1 || ops[0].Op != OpQuery {
+		t.Errorf("expected query to be in flight, got %+v", ops)
+	}
+	rows.Close()
+	if ops := hook.Operations(); len(ops) != 0 {
+		t.Errorf("expected no operations after close, got %+v", ops)
+	}
+}
+
+func TestMySQL_InFlightCancel(t *testing.T) {
+	hook := NewInFlightHook(nil)
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook)))
+	defer db.Close()
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := db.ExecContext(context.Background(), "SELECT SLEEP(30)")
+		errc <- err
+	}()
+
+	ops := waitInFlight(t, srv, 1)
+	if code := cancelInFlight(t, srv, ops[0].ID); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("expected canceled query to fail")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("SLEEP was not canceled")
+	}
+}
+
+func TestPostgreSQL_InFlightCancel(t *testing.T) {
+	hook := NewInFlightHook(nil)
+	db := sql.OpenDB(NewCustomConnector(pgConnector, nil, WithHooks(hook)))
+	defer db.Close()
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := db.ExecContext(context.Background(), "SELECT pg_sleep(30)")
+		errc <- err
+	}()
+
+	ops := waitInFlight(t, srv, 1)
+	if code := cancelInFlight(t, srv, ops[0].ID); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+	select {
+	case err := <-errc:
+		if Classify(err) != CategoryQueryCanceled {
+			t.Errorf("expected query_canceled, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("pg_sleep was not canceled")
+	}
+}
@@ -0,0 +1,279 @@
+package customdriver
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLeakMaxAge = time.Minute
+	leakStackDepth    = 32
+)
+
+// ObjectKind は LeakDetector が追跡するオブジェクトの種類
+type ObjectKind string
+
+const (
+	ObjectRows ObjectKind = "rows"
+	ObjectStmt ObjectKind = "stmt"
+	ObjectTx   ObjectKind = "tx"
+)
+
+// LeakOptions は LeakDetector の設定
+type LeakOptions struct {
+	// これより長く開いたままのオブジェクトをリークとして報告する。0 の場合は 1 分
+	MaxAge time.Duration
+}
+
+// OpenObject は開いたままの Rows / Stmt / Tx
+type OpenObject struct {
+	// LeakDetector ごとに生成順に振られる識別子
+	ID     uint64
+	Kind   ObjectKind
+	ConnID uint64
+	// Rows と Stmt のクエリ。Tx の場合は空文字列
+	Query   string
+	Created time.Time
+	Age     time.Duration
+	// 生成した時点のスタック。customdriver 内のフレームは除く
+	Stack string
+}
+
+// LeakDetector は Rows / Stmt / Tx を生成時のスタックとともに記録し、
+// Close / Commit / Rollback されないまま MaxAge を過ぎたものを報告する。
+//
+// 経過時間の確認は報告していないオブジェクトが MaxAge を過ぎる時刻にタイマーで行うため、新しいクエリがなくても報告される。
+// CustomConnector の Close (sql.DB の Close から呼ばれる) では開いたままの全てのオブジェクトを報告する。
+// 報告は logger への警告で、同じオブジェクトは 1 回だけ報告する
+type LeakDetector struct {
+	logger *slog.Logger
+	maxAge time.Duration
+	now    func() time.Time
+	// afterFunc は d 後に f を呼び出す
+	afterFunc func(d time.Duration, f func())
+
+	mu   sync.Mutex
+	seq  uint64
+	open map[uint64]*trackedObject
+	// 経過時間を確認するタイマーが待機中か
+	checkScheduled bool
+}
+
+type trackedObject struct {
+	id       uint64
+	kind     ObjectKind
+	connID   uint64
+	query    string
+	created  time.Time
+	stack    []uintptr
+	reported bool
+}
+
+// logger が nil の場合は報告せず、Open / Leaks で確認する。opts が nil の場合は既定値を使う
+func NewLeakDetector(logger *slog.Logger, opts *LeakOptions) *LeakDetector {
+	d := &LeakDetector{
+		logger:    logger,
+		maxAge:    defaultLeakMaxAge,
+		now:       time.Now,
+		afterFunc: func(d time.Duration, f func()) { time.AfterFunc(d, f) },
+		open:      map[uint64]*trackedObject{},
+	}
+	if opts != nil && opts.MaxAge > 0 {
+		d.maxAge = opts.MaxAge
+	}
+	return d
+}
+
+// WithLeakDetector は d で Rows / Stmt / Tx のリークを検出する。
+// 複数の CustomConnector で同じ LeakDetector を共有できる
+func WithLeakDetector(d *LeakDetector) Option {
+	return func(cfg *config) {
+		cfg.leaks = d
+	}
+}
+
+// Open は開いたままの全てのオブジェクトを古い順に返す
+func (d *LeakDetector) Open() []OpenObject {
+	return d.collect(0)
+}
+
+// Leaks は MaxAge を過ぎて開いたままのオブジェクトを古い順に返す
+func (d *LeakDetector) Leaks() []OpenObject {
+	return d.collect(d.maxAge)
+}
+
+func (d *LeakDetector) collect(minAge time.Duration) []OpenObject {
+	now := d.now()
+
+	d.mu.Lock()
+	var objs []*trackedObject
+	for _, o := range d.open {
+		if now.Sub(o.created) >= minAge {
+			objs = append(objs, o)
+		}
+	}
+	d.mu.Unlock()
+
+	result := make([]OpenObject, 0, len(objs))
+	for _, o := range objs {
+		result = append(result, o.snapshot(now))
+	}
+	slices.SortFunc(result, func(a, b OpenObject) int {
+		return cmp.Or(a.Created.Compare(b.Created), cmp.Compare(a.ID, b.ID))
+	})
+	return result
+}
+
+// track は生成されたオブジェクトを記録し、Close 時に untrack に渡す ID を返す。d が nil の場合は何もしない
+func (d *LeakDetector) track(kind ObjectKind, connID uint64, query string) uint64 {
+	if d == nil {
+		return 0
+	}
+	var pcs [leakStackDepth]uintptr
+	n := runtime.Callers(2, pcs[:])
+	now := d.now()
+
+	d.mu.Lock()
+	d.seq++
+	id := d.seq
+	d.open[id] = &trackedObject{
+		id:      id,
+		kind:    kind,
+		connID:  connID,
+		query:   query,
+		created: now,
+		stack:   slices.Clone(pcs[:n]),
+	}
+	d.scheduleCheck(now, now.Add(d.maxAge))
+	d.mu.Unlock()
+	return id
+}
+
+// scheduleCheck は at に check を呼び出す。既に待機中のタイマーがあればそちらに任せる。d.mu を取得して呼び出す
+func (d *LeakDetector) scheduleCheck(now, at time.Time) {
+	if d.checkScheduled {
+		return
+	}
+	d.checkScheduled = true
+	d.afterFunc(at.Sub(now), d.check)
+}
+
+// check は MaxAge を過ぎたオブジェクトを報告し、まだ過ぎていないものがあれば次の確認を予約する
+func (d *LeakDetector) check() {
+	now := d.now()
+
+	d.mu.Lock()
+	d.checkScheduled = false
+	leaks := d.unreported(now, d.maxAge)
+	var next time.Time
+	for _, o := range d.open {
+		if !o.reported && (next.IsZero() || o.created.Before(next)) {
+			next = o.created
+		}
+	}
+	if !next.IsZero() {
+		d.scheduleCheck(now, next.Add(d.maxAge))
+	}
+	d.mu.Unlock()
+
+	d.report(leaks, "max_age")
+}
+
+func (d *LeakDetector) untrack(id uint64) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.open, id)
+}
+
+// unreported はまだ報告していない minAge 以上のオブジェクトを報告済みにして返す。d.mu を取得して呼び出す
+func (d *LeakDetector) unreported(now time.Time, minAge time.Duration) []OpenObject {
+	var result []OpenObject
+	for _, o := range d.open {
+		if !o.reported && now.Sub(o.created) >= minAge {
+			o.reported = true
+			result = append(result, o.snapshot(now))
+		}
+	}
+	slices.SortFunc(result, func(a, b OpenObject) int {
+		return cmp.Or(a.Created.Compare(b.Created), cmp.Compare(a.ID, b.ID))
+	})
+	return result
+}
+
+// closeCheck は開いたままのオブジェクトを全て報告する。CustomConnector の Close から呼ばれる
+func (d *LeakDetector) closeCheck() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	leaks := d.unreported(d.now(), 0)
+	d.mu.Unlock()
+	d.report(leaks, "close")
+}
+
+func (d *LeakDetector) report(leaks []OpenObject, reason string) {
+	if d.logger == nil {
+		return
+	}
+	for _, o := range leaks {
+		d.logger.WarnContext(context.Background(), "leak detected",
+			slog.String("kind", string(o.Kind)),
+			slog.Uint64("conn_id", o.ConnID),
+			slog.String("query", o.Query),
+			slog.Duration("age", o.Age),
+			slog.String("reason", reason),
+			slog.String("stack", o.Stack),
+		)
+	}
+}
+
+func (o *trackedObject) snapshot(now time.Time) OpenObject {
+	return OpenObject{
+		ID:      o.id,
+		Kind:    o.kind,
+		ConnID:  o.connID,
+		Query:   o.query,
+		Created: o.created,
+		Age:     now.Sub(o.created),
+		Stack:   formatStack(o.stack),
+	}
+}
+
+// formatStack は customdriver 内 (テストを除く) のフレームを除いてスタックを整形する
+func formatStack(pcs []uintptr) string {
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		if funcPackage(f.Function) != customdriverPkg || strings.HasSuffix(f.File, "_test.go") {
+			b.WriteString(f.Function)
+			b.WriteString("\n\t")
+			b.WriteString(f.File)
+			b.WriteByte(':')
+			b.WriteString(strconv.Itoa(f.Line))
+			b.WriteByte('\n')
+		}
+		if !more {
+			return b.String()
+		}
+	}
+}
+
+// AssertNoLeaks は d に開いたままのオブジェクトがあればテストを失敗させる。
+// テストの最後に t.Cleanup から呼び出すことを想定している
+func AssertNoLeaks(t TB, d *LeakDetector) {
+	t.Helper()
+	for _, o := range d.Open() {
+		t.Errorf("%s leaked (conn %d, open for %v): %q\n%s", o.Kind, o.ConnID, o.Age, o.Query, o.Stack)
+	}
+}
@@ -0,0 +1,162 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// txConn は Begin で何もしない Tx を返す legacyConn
+type txConn struct {
+	legacyConn
+}
+
+func (c *txConn) Begin() (driver.Tx, error) {
+	return nopTx{}, nil
+}
+
+type nopTx struct{}
+
+func (nopTx) Commit() error   { return nil }
+func (nopTx) Rollback() error { return nil }
+
+// newLeakDB は fake clock を使う LeakDetector で記録する DB を返す
+func newLeakDB(t *testing.T) (*sql.DB, *LeakDetector, *bytes.Buffer, *fakeClock) {
+	t.Helper()
+
+	var detector *LeakDetector
+	db, buf, clock := newFakeClockDB(t, &staticConnector{conn: &txConn{}}, func(logger *slog.Logger, clock *fakeClock) Option {
+		detector = NewLeakDetector(logger, &LeakOptions{MaxAge: time.Minute})
+		detector.now = clock.Now
+		detector.afterFunc = clock.AfterFunc
+		return WithLeakDetector(detector)
+	})
+	return db, detector, buf, clock
+}
+
+// =============================================================================
+// Leak Detector Tests
+// =============================================================================
+
+func TestLeakDetector_MaxAge(t *testing.T) {
+	db, detector, buf, clock := newLeakDB(t)
+	ctx := context.Background()
+
+	rows, err := db.QueryContext(ctx, "SELECT name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	stmt, err := db.PrepareContext(ctx, "DELETE FROM users WHERE id = ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+
+	open := detector.Open()
+	if len(open) != 3 {
+		t.Fatalf("expected 3 open objects, got %+v", open)
+	}
+	for i, kind := range []ObjectKind{ObjectRows, ObjectStmt, ObjectTx} {
+		if open[i].Kind != kind {
+			t.Errorf("object %d: expected %s, got %s", i, kind, open[i].Kind)
+		}
+		if !strings.Contains(open[i].Stack, "leak_test.go:") || strings.Contains(open[i].Stack, "(*LeakDetector).track") {
+			t.Errorf("unexpected stack for %s:\n%s", kind, open[i].Stack)
+		}
+	}
+	if open[0].Query != "SELECT name FROM users" {
+		t.Errorf("unexpected query: %q", open[0].Query)
+	}
+	if leaks := detector.Leaks(); len(leaks) != 0 {
+		t.Errorf("expected no leaks before MaxAge, got %+v", leaks)
+	}
+
+	// MaxAge を過ぎるとタイマーで確認が行われる。新しいクエリは必要ない
+	clock.Advance(2 * time.Minute)
+	if leaks := detector.Leaks(); len(leaks) != 3 || leaks[0].Age != 2*time.Minute {
+		t.Errorf("expected 3 leaks, got %+v", leaks)
+	}
+	records := decodeLogs(t, buf)
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %v", records)
+	}
+	for _, r := range records {
+		if r["msg"] != "leak detected" || r["reason"] != "max_age" {
+			t.Errorf("unexpected record: %v", r)
+		}
+	}
+
+	// 報告済みのオブジェクトは再度報告しない。後から生成したものは MaxAge を過ぎたときに報告する
+	later, err := db.QueryContext(ctx, "SELECT id FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	clock.Advance(time.Minute)
+	records = decodeLogs(t, buf)
+	if len(records) != 1 || records[0]["query"] != "SELECT id FROM users" {
+		t.Fatalf("expected only the later rows to be reported, got %v", records)
+	}
+	later.Close()
+
+	rows.Close()
+	stmt.Close()
+	tx.Commit()
+	var tb recordingTB
+	AssertNoLeaks(&tb, detector)
+	if len(tb.errors) != 0 {
+		t.Errorf("expected no leaks after close, got %v", tb.errors)
+	}
+}
+
+func TestLeakDetector_Close(t *testing.T) {
+	db, detector, buf, _ := newLeakDB(t)
+
+	if _, err := db.Query("SELECT name FROM users"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	var tb recordingTB
+	AssertNoLeaks(&tb, detector)
+	if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], "rows leaked") {
+		t.Errorf("unexpected errors: %v", tb.errors)
+	}
+
+	db.Close()
+	records := decodeLogs(t, buf)
+	if len(records) != 1 || records[0]["kind"] != "rows" || records[0]["reason"] != "close" {
+		t.Errorf("unexpected records: %v", records)
+	}
+}
+
+// legacyDriver は DriverContext を実装しないドライバー
+type legacyDriver struct{}
+
+func (legacyDriver) Open(name string) (driver.Conn, error) {
+	return &txConn{}, nil
+}
+
+func TestLeakDetector_CloseWithoutDriverContext(t *testing.T) {
+	var buf bytes.Buffer
+	detector := NewLeakDetector(slog.New(slog.NewJSONHandler(&buf, nil)), nil)
+	connector, err := NewCustomDriver(legacyDriver{}, nil, WithLeakDetector(detector)).OpenConnector("dsn")
+	if err != nil {
+		t.Fatalf("OpenConnector failed: %v", err)
+	}
+	db := sql.OpenDB(connector)
+
+	if _, err := db.Query("SELECT name FROM users"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	db.Close()
+	records := decodeLogs(t, &buf)
+	if len(records) != 1 || records[0]["kind"] != "rows" || records[0]["reason"] != "close" {
+		t.Errorf("unexpected records: %v", records)
+	}
+}
@@ -0,0 +1,56 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+)
+
+// 内部ドライバーが context 対応のインターフェースを持たず、
+// driver.Execer / driver.Queryer / driver.Stmt の Exec・Query だけを実装している場合でも、
+// ラッパーは context 対応のインターフェースを公開してフックに context を渡す。
+// 変換は database/sql が内部で行うものと同じにする。
+
+// database/sql が名前付き引数を非対応のドライバーに渡そうとしたときと同じエラー
+var errNamedParams = errors.New("sql: driver does not support the use of Named Parameters")
+
+func valuesToNamedValues(args []driver.Value) []driver.NamedValue {
+	nargs := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		nargs[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return nargs
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	dargs := make([]driver.Value, len(args))
+	for i, nv := range args {
+		if nv.Name != "" {
+			return nil, errNamedParams
+		}
+		dargs[i] = nv.Value
+	}
+	return dargs, nil
+}
+
+func execLegacy(ctx context.Context, args []driver.NamedValue, exec func([]driver.Value) (driver.Result, error)) (driver.Result, error) {
+	dargs, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return exec(dargs)
+}
+
+func queryLegacy(ctx context.Context, args []driver.NamedValue, query func([]driver.Value) (driver.Rows, error)) (driver.Rows, error) {
+	dargs, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return query(dargs)
+}
@@ -0,0 +1,168 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/replu/goconmini-sendai-2026/constdriver"
+)
+
+// legacyConn は context 非対応の Execer / Queryer だけを実装する最小限のコネクション
+type legacyConn struct {
+	constdriver.Conn
+	prepares, execs, queries int
+}
+
+func (c *legacyConn) Prepare(query string) (driver.Stmt, error) {
+	c.prepares++
+	return c.Conn.Prepare(query)
+}
+
+func (c *legacyConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	c.execs++
+	return driver.RowsAffected(1), nil
+}
+
+func (c *legacyConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	c.queries++
+	return (&constdriver.Stmt{}).Query(args)
+}
+
+// skipConn は ExecerContext を実装するが常に driver.ErrSkip を返す
+type skipConn struct {
+	legacyConn
+}
+
+func (c *skipConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+
+type staticConnector struct {
+	conn driver.Conn
+}
+
+func (c *staticConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func (c *staticConnector) Driver() driver.Driver {
+	return &constdriver.Driver{}
+}
+
+// =============================================================================
+// Legacy Driver Tests
+// =============================================================================
+
+func TestLegacyDriver_ExecQuery(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	conn := &legacyConn{}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, logger))
+	defer db.Close()
+	ctx := WithAttrs(context.Background(), slog.String("request_id", "req-legacy"))
+
+	if _, err := db.ExecContext(ctx, "UPDATE users SET name = ?", "alice"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users WHERE id > ?", 0)
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	var names []string
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			t.Fatalf("rows.Scan failed: %v", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+
+	if conn.execs != 1 || conn.queries != 1 || conn.prepares != 0 {
+		t.Errorf("expected 1 exec, 1 query and no prepare, got execs=%d queries=%d prepares=%d", conn.execs, conn.queries, conn.prepares)
+	}
+	if strings.Join(names, ",") != "Alice,Bob" {
+		t.Errorf("unexpected rows %v", names)
+	}
+
+	var logged int
+	for _, r := range decodeLogs(t, &buf) {
+		if r["msg"] == "sql executed" || r["msg"] == "sql queried" {
+			logged++
+			if r["request_id"] != "req-legacy" {
+				t.Errorf("expected request_id in %q record, got %v", r["msg"], r)
+			}
+		}
+	}
+	if logged != 2 {
+		t.Errorf("expected 2 exec/query records, got %d", logged)
+	}
+}
+
+func TestLegacyDriver_NamedArgs(t *testing.T) {
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil))
+	defer db.Close()
+
+	_, err := db.ExecContext(context.Background(), "UPDATE users SET name = :name", sql.Named("name", "alice"))
+	if !errors.Is(err, errNamedParams) {
+		t.Errorf("expected %v, got %v", errNamedParams, err)
+	}
+}
+
+func TestLegacyDriver_Stmt(t *testing.T) {
+	hook := &recordingHook{name: "legacy"}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+
+	stmt, err := db.Prepare("SELECT id, name FROM users WHERE id > ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	defer stmt.Close()
+
+	var id int
+	var name string
+	if err := stmt.QueryRowContext(context.Background(), 0).Scan(&id, &name); err != nil {
+		t.Fatalf("QueryRowContext failed: %v", err)
+	}
+	if name != "Alice" {
+		t.Errorf("expected Alice, got %q", name)
+	}
+
+	e, ok := hook.find(OpQuery)
+	if !ok || !e.Stmt {
+		t.Errorf("expected stmt query event, got %v", hook.ops())
+	}
+}
+
+func TestLegacyDriver_SkipWarnedOncePerConn(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &skipConn{}}, logger))
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for range 3 {
+		if _, err := db.Exec("UPDATE users SET name = ?", "alice"); err != nil {
+			t.Fatalf("Exec failed: %v", err)
+		}
+	}
+
+	var warnings int
+	for _, r := range decodeLogs(t, &buf) {
+		if strings.HasPrefix(r["msg"].(string), "original driver skipped") {
+			warnings++
+		}
+	}
+	if warnings != 1 {
+		t.Errorf("expected 1 warning, got %d", warnings)
+	}
+}
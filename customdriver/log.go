@@ -0,0 +1,388 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	_ Hook     = (*LogHook)(nil)
+	_ SkipHook = (*LogHook)(nil)
+	_ PlanHook = (*LogHook)(nil)
+)
+
+// LogOptions は LogHook の出力を調整する
+type LogOptions struct {
+	// 成功した Exec / Query / トランザクション操作を出力するレベル。nil の場合は slog.LevelInfo
+	Level slog.Leveler
+	// true の場合、引数の値を出力しない
+	RedactArgs bool
+	// 引数ごとに伏せる・切り詰めるなどの出力方法。RedactArgs が true の場合は使われない
+	Args ArgPolicy
+	// 0 < SampleRate < 1 の場合、成功した操作のうちこの割合だけを出力する。
+	// 操作の種類とクエリのフィンガープリントごとに数え、乱数は使わない。
+	// エラーと遅いクエリは常に出力する
+	SampleRate float64
+	// 0 より大きい場合、成功した操作のレコードをフィンガープリントごとに RateLimitWindow あたりこの件数までにする。
+	// 出力しなかった件数は期間の終了時に "similar records suppressed" として出力する
+	RateLimit int
+	// RateLimit の期間。0 の場合は 1 秒
+	RateLimitWindow time.Duration
+	// 0 より大きい場合、Exec の影響を受けた行数がこれを超えたときに "too many rows affected" を警告する。
+	// 条件の漏れた UPDATE / DELETE を見つけるために使う
+	MaxRowsAffected int64
+}
+
+// LogHook はドライバー操作を slog で出力する組み込みのフック
+type LogHook struct {
+	logger  *slog.Logger
+	opts    LogOptions
+	sampler *sampler
+
+	// driver.ErrSkip の警告をコネクションごとに 1 回だけ出すための記録
+	mu      sync.Mutex
+	skipped map[skipKey]struct{}
+}
+
+type skipKey struct {
+	connID uint64
+	op     Op
+}
+
+// opts が nil の場合は既定値を使う
+func NewLogHook(logger *slog.Logger, opts *LogOptions) *LogHook {
+	h := &LogHook{
+		logger:  logger,
+		skipped: map[skipKey]struct{}{},
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Level == nil {
+		h.opts.Level = slog.LevelInfo
+	}
+	h.sampler = newSampler(&h.opts)
+	h.sampler.flush = h.logSuppressed
+	return h
+}
+
+func (h *LogHook) Before(ctx context.Context, e *Event) context.Context {
+	return ctx
+}
+
+func (h *LogHook) After(ctx context.Context, e *Event) {
+	if e.Op == OpClose {
+		h.mu.Lock()
+		delete(h.skipped, skipKey{e.ConnID, OpExec})
+		delete(h.skipped, skipKey{e.ConnID, OpQuery})
+		h.mu.Unlock()
+	}
+
+	okMsg, errMsg := logMessages(e)
+	level := h.opts.Level.Level()
+	// コネクションのライフサイクルを追えるよう、接続・切断などはサンプリングしない
+	sampling := true
+	switch e.Op {
+	case OpPrepare, OpPing:
+		level = slog.LevelDebug
+	case OpConnect, OpClose, OpResetSession:
+		sampling = false
+	case OpInvalid:
+		level = slog.LevelWarn
+		sampling = false
+	}
+	h.logSuppressed()
+	if e.SlowThreshold > 0 {
+		h.logSlow(ctx, e)
+	}
+	if e.MultipleRows {
+		h.logMultipleRows(ctx, e)
+	}
+	if e.Op == OpExec && e.Err == nil && h.opts.MaxRowsAffected > 0 && e.RowsAffected > h.opts.MaxRowsAffected {
+		h.logTooManyRows(ctx, e)
+	}
+	if e.Err == nil && e.SlowThreshold == 0 && sampling && h.sampler.enabled() && !h.sampler.allow(e) {
+		return
+	}
+
+	attrs := append(slices.Clip(AttrsFromContext(ctx)), slog.Uint64("conn_id", e.ConnID))
+	if e.StmtID != 0 {
+		attrs = append(attrs, slog.Uint64("stmt_id", e.StmtID))
+	}
+	if e.TxID != 0 {
+		attrs = append(attrs, slog.Uint64("tx_id", e.TxID))
+	}
+	if e.QueryName != "" {
+		attrs = append(attrs, slog.String("query_name", e.QueryName))
+	}
+	if e.Command != "" {
+		attrs = append(attrs, slog.String("query_command", e.Command))
+	}
+	if e.Query != "" {
+		attrs = append(attrs, slog.String("query", e.Query))
+	}
+	if e.Caller.File != "" {
+		attrs = append(attrs, callerAttr(e.Caller))
+	}
+	switch e.Op {
+	case OpExec:
+		attrs = append(attrs, slog.Any("args", h.args(e.Args, e.QueryName)))
+		if e.Err == nil {
+			attrs = append(attrs, slog.Int64("rows_affected", e.RowsAffected))
+			if e.LastInsertID != 0 {
+				attrs = append(attrs, slog.Int64("last_insert_id", e.LastInsertID))
+			}
+		}
+	case OpQuery:
+		attrs = append(attrs, slog.Any("args", h.args(e.Args, e.QueryName)))
+	case OpBegin:
+		attrs = append(attrs,
+			slog.Any("isolation", e.TxOptions.Isolation),
+			slog.Bool("read_only", e.TxOptions.ReadOnly),
+		)
+	case OpCommit, OpRollback:
+		if e.Tx != nil {
+			attrs = append(attrs, h.txAttrs(e)...)
+		}
+	case OpRowsClose:
+		attrs = append(attrs,
+			slog.Int64("rows", e.RowsRead),
+			slog.Duration("first_row", e.FirstRow),
+		)
+	}
+	attrs = append(attrs, slog.Duration("duration", e.Duration))
+	if e.Attempt > 1 || e.RetryBackoff > 0 {
+		attrs = append(attrs, slog.Int("attempt", e.Attempt))
+	}
+
+	if e.Err != nil {
+		attrs = append(attrs, slog.Any("error", e.Err), slog.String("error_category", string(e.Category)))
+		// リトライする失敗はエラーではなく警告として出力する
+		if e.RetryBackoff > 0 {
+			attrs = append(attrs, slog.Duration("backoff", e.RetryBackoff))
+			h.logger.LogAttrs(ctx, slog.LevelWarn, "retrying after transient error", attrs...)
+			return
+		}
+		h.logger.LogAttrs(ctx, slog.LevelError, errMsg, attrs...)
+		return
+	}
+	h.logger.LogAttrs(ctx, level, okMsg, attrs...)
+}
+
+// logSlow は遅いクエリを専用の警告として出力する
+func (h *LogHook) logSlow(ctx context.Context, e *Event) {
+	h.logger.LogAttrs(ctx, slog.LevelWarn, "slow query", h.slowAttrs(ctx, e)...)
+}
+
+// Planned は遅いクエリの実行計画を "slow query" の後続の警告として出力する
+func (h *LogHook) Planned(ctx context.Context, e *Event) {
+	attrs := h.slowAttrs(ctx, e)
+	switch {
+	case e.PlanErr != nil:
+		attrs = append(attrs, slog.Any("plan_error", e.PlanErr))
+	case json.Valid([]byte(e.Plan)):
+		// JSONHandler では実行計画をそのまま JSON として埋め込む
+		attrs = append(attrs, slog.Any("plan", json.RawMessage(e.Plan)))
+	default:
+		attrs = append(attrs, slog.String("plan", e.Plan))
+	}
+	h.logger.LogAttrs(ctx, slog.LevelWarn, "slow query plan", attrs...)
+}
+
+func (h *LogHook) slowAttrs(ctx context.Context, e *Event) []slog.Attr {
+	attrs := append(slices.Clip(AttrsFromContext(ctx)), slog.Uint64("conn_id", e.ConnID))
+	if e.StmtID != 0 {
+		attrs = append(attrs, slog.Uint64("stmt_id", e.StmtID))
+	}
+	if e.TxID != 0 {
+		attrs = append(attrs, slog.Uint64("tx_id", e.TxID))
+	}
+	if e.QueryName != "" {
+		attrs = append(attrs, slog.String("query_name", e.QueryName))
+	}
+	attrs = append(attrs,
+		slog.String("query", e.Query),
+		slog.Any("args", h.args(e.Args, e.QueryName)),
+	)
+	if e.Caller.File != "" {
+		attrs = append(attrs, callerAttr(e.Caller))
+	}
+	return append(attrs,
+		slog.Duration("duration", e.Duration),
+		slog.Duration("threshold", e.SlowThreshold),
+	)
+}
+
+// logMultipleRows は sqlc の :one のクエリが 2 行以上を返したことを警告する
+func (h *LogHook) logMultipleRows(ctx context.Context, e *Event) {
+	attrs := append(slices.Clip(AttrsFromContext(ctx)),
+		slog.Uint64("conn_id", e.ConnID),
+		slog.String("query_name", e.QueryName),
+		slog.String("query", e.Query),
+		slog.Any("args", h.args(e.Args, e.QueryName)),
+	)
+	if e.Caller.File != "" {
+		attrs = append(attrs, callerAttr(e.Caller))
+	}
+	h.logger.LogAttrs(ctx, slog.LevelWarn, "multiple rows returned for :one query", attrs...)
+}
+
+// logTooManyRows は Exec の影響を受けた行数が LogOptions.MaxRowsAffected を超えたことを警告する
+func (h *LogHook) logTooManyRows(ctx context.Context, e *Event) {
+	attrs := append(slices.Clip(AttrsFromContext(ctx)), slog.Uint64("conn_id", e.ConnID))
+	if e.TxID != 0 {
+		attrs = append(attrs, slog.Uint64("tx_id", e.TxID))
+	}
+	if e.QueryName != "" {
+		attrs = append(attrs, slog.String("query_name", e.QueryName))
+	}
+	attrs = append(attrs,
+		slog.String("query", e.Query),
+		slog.Any("args", h.args(e.Args, e.QueryName)),
+	)
+	if e.Caller.File != "" {
+		attrs = append(attrs, callerAttr(e.Caller))
+	}
+	attrs = append(attrs,
+		slog.Int64("rows_affected", e.RowsAffected),
+		slog.Int64("limit", h.opts.MaxRowsAffected),
+	)
+	h.logger.LogAttrs(ctx, slog.LevelWarn, "too many rows affected", attrs...)
+}
+
+func callerAttr(c Caller) slog.Attr {
+	return slog.Group("caller",
+		slog.String("function", c.Function),
+		slog.String("file", c.File),
+		slog.Int("line", c.Line),
+	)
+}
+
+// Skipped は内部ドライバーが driver.ErrSkip を返したことをコネクションごとに 1 回だけ警告する。
+// database/sql はこの後 Prepare・Exec・Close の順に呼び出すため、ラウンドトリップが増える
+func (h *LogHook) Skipped(ctx context.Context, e *Event) {
+	key := skipKey{e.ConnID, e.Op}
+	h.mu.Lock()
+	_, warned := h.skipped[key]
+	h.skipped[key] = struct{}{}
+	h.mu.Unlock()
+	if warned {
+		return
+	}
+
+	var msg string
+	switch e.Op {
+	case OpExec:
+		msg = "original driver skipped ExecContext, falling back to prepared statement"
+	case OpQuery:
+		msg = "original driver skipped QueryContext, falling back to prepared statement"
+	default:
+		return
+	}
+	h.logger.LogAttrs(ctx, slog.LevelWarn, msg, slog.Uint64("conn_id", e.ConnID))
+}
+
+// txAttrs はトランザクションの要約を返す。
+// ステートメントの履歴はロールバックまたはコミットに失敗した場合のみ含める
+func (h *LogHook) txAttrs(e *Event) []slog.Attr {
+	s := e.Tx
+	attrs := []slog.Attr{
+		slog.Any("isolation", s.Isolation),
+		slog.Bool("read_only", s.ReadOnly),
+		slog.Duration("lifetime", s.Lifetime),
+		slog.Duration("idle", s.Idle),
+		slog.Int("statements", s.Statements),
+		slog.Int64("rows_affected", s.RowsAffected),
+	}
+	if e.Op == OpCommit && e.Err == nil {
+		return attrs
+	}
+
+	// JSONHandler でも読めるよう、各ステートメントは map で出力する
+	journal := make([]map[string]any, 0, len(s.Journal))
+	for _, st := range s.Journal {
+		entry := map[string]any{
+			"query":    st.Query,
+			"offset":   st.Start.Sub(s.Begin),
+			"duration": st.Duration,
+		}
+		entry["args"] = h.args(st.Args, st.QueryName)
+		if st.RowsAffected != 0 {
+			entry["rows_affected"] = st.RowsAffected
+		}
+		if st.Err != nil {
+			entry["error"] = st.Err.Error()
+		}
+		journal = append(journal, entry)
+	}
+	attrs = append(attrs, slog.Any("journal", journal))
+	if s.JournalDropped > 0 {
+		attrs = append(attrs, slog.Int("journal_dropped", s.JournalDropped))
+	}
+	return attrs
+}
+
+// args は LogOptions に従って引数を出力用に変換する
+func (h *LogHook) args(args []driver.NamedValue, queryName string) any {
+	return argsValue(args, queryName, h.opts.RedactArgs, &h.opts.Args)
+}
+
+// logSuppressed は RateLimit で出力しなかったレコードの件数を出力する
+func (h *LogHook) logSuppressed() {
+	for _, r := range h.sampler.sweep() {
+		attrs := []slog.Attr{slog.String("op", string(r.key.op))}
+		if r.key.fingerprint != "" {
+			attrs = append(attrs, slog.String("fingerprint", r.key.fingerprint))
+		}
+		attrs = append(attrs,
+			slog.Int("suppressed", r.count),
+			slog.Duration("window", h.sampler.window),
+		)
+		h.logger.LogAttrs(context.Background(), slog.LevelInfo, "similar records suppressed", attrs...)
+	}
+}
+
+// logMessages は操作ごとの成功時・失敗時のメッセージを返す
+func logMessages(e *Event) (string, string) {
+	switch e.Op {
+	case OpConnect:
+		return "connection opened", "connection failed"
+	case OpPrepare:
+		return "stmt prepared", "stmt prepare failed"
+	case OpExec:
+		if e.Stmt {
+			return "stmt executed", "stmt execution failed"
+		}
+		return "sql executed", "sql execution failed"
+	case OpQuery:
+		if e.Stmt {
+			return "stmt queried", "stmt query failed"
+		}
+		return "sql queried", "sql query failed"
+	case OpBegin:
+		return "transaction started", "transaction begin failed"
+	case OpCommit:
+		return "transaction committed", "transaction commit failed"
+	case OpRollback:
+		return "transaction rolled back", "transaction rollback failed"
+	case OpPing:
+		return "ping succeeded", "ping failed"
+	case OpResetSession:
+		return "session reset", "session reset failed"
+	case OpClose:
+		return "connection closed", "connection close failed"
+	case OpInvalid:
+		return "connection marked invalid", "connection marked invalid"
+	case OpRowsClose:
+		if e.Stmt {
+			return "stmt rows closed", "stmt rows iteration failed"
+		}
+		return "sql rows closed", "sql rows iteration failed"
+	}
+	return string(e.Op), string(e.Op) + " failed"
+}
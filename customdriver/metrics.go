@@ -0,0 +1,245 @@
+package customdriver
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	_ Hook         = (*MetricsHook)(nil)
+	_ SkipHook     = (*MetricsHook)(nil)
+	_ http.Handler = (*MetricsHook)(nil)
+)
+
+// DefaultMetricsBuckets はレイテンシーのヒストグラムの既定のバケット (秒)
+var DefaultMetricsBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+// MetricsOptions は MetricsHook の集計方法を調整する
+type MetricsOptions struct {
+	// メトリクス名の接頭辞。空の場合は "customdriver"
+	Namespace string
+	// レイテンシーのヒストグラムのバケット (秒)。nil の場合は DefaultMetricsBuckets
+	Buckets []float64
+	// true の場合、WithQueryName で付けた名前を query_name ラベルとして付ける。
+	// 名前の種類だけ系列が増えるため、固定の名前だけを使う場合に有効にする
+	QueryNameLabel bool
+}
+
+// MetricsHook は操作ごとの回数・エラー数・レイテンシーと、
+// 開いているコネクション数・実行中のクエリ数を集計するフック。
+// ServeHTTP は集計結果を Prometheus のテキスト形式で返す
+type MetricsHook struct {
+	opts MetricsOptions
+
+	mu     sync.Mutex
+	series map[metricsKey]*metricsSeries
+
+	openConns atomic.Int64
+	inFlight  atomic.Int64
+}
+
+type metricsKey struct {
+	op        Op
+	queryName string
+}
+
+type metricsSeries struct {
+	count   uint64
+	errors  uint64
+	buckets []uint64
+	sum     float64
+}
+
+// opts が nil の場合は既定値を使う
+func NewMetricsHook(opts *MetricsOptions) *MetricsHook {
+	h := &MetricsHook{
+		series: map[metricsKey]*metricsSeries{},
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Namespace == "" {
+		h.opts.Namespace = "customdriver"
+	}
+	if h.opts.Buckets == nil {
+		h.opts.Buckets = DefaultMetricsBuckets
+	}
+	h.opts.Buckets = slices.Sorted(slices.Values(h.opts.Buckets))
+	return h
+}
+
+func (h *MetricsHook) Before(ctx context.Context, e *Event) context.Context {
+	switch e.Op {
+	case OpExec, OpQuery:
+		h.inFlight.Add(1)
+	}
+	return ctx
+}
+
+// Skipped は driver.ErrSkip で実行されなかった Exec / Query を実行中の数から除く
+func (h *MetricsHook) Skipped(ctx context.Context, e *Event) {
+	switch e.Op {
+	case OpExec, OpQuery:
+		h.inFlight.Add(-1)
+	}
+}
+
+func (h *MetricsHook) After(ctx context.Context, e *Event) {
+	// クエリは結果を読み終えて Rows が閉じられるまで実行中とみなす
+	switch e.Op {
+	case OpExec:
+		h.inFlight.Add(-1)
+	case OpQuery:
+		if e.Err != nil {
+			h.inFlight.Add(-1)
+		}
+	case OpRowsClose:
+		h.inFlight.Add(-1)
+		return
+	case OpConnect:
+		if e.Err == nil {
+			h.openConns.Add(1)
+		}
+	case OpClose:
+		h.openConns.Add(-1)
+	case OpInvalid:
+		return
+	}
+
+	key := metricsKey{op: e.Op}
+	if h.opts.QueryNameLabel {
+		key.queryName = e.QueryName
+	}
+	seconds := e.Duration.Seconds()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &metricsSeries{buckets: make([]uint64, len(h.opts.Buckets))}
+		h.series[key] = s
+	}
+	s.count++
+	if e.Err != nil {
+		s.errors++
+	}
+	s.sum += seconds
+	if i, _ := slices.BinarySearch(h.opts.Buckets, seconds); i < len(s.buckets) {
+		s.buckets[i]++
+	}
+}
+
+func (h *MetricsHook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := h.WriteTo(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// WriteTo は集計結果を Prometheus のテキスト形式で w に書き出す
+func (h *MetricsHook) WriteTo(w io.Writer) (int64, error) {
+	type entry struct {
+		key metricsKey
+		s   metricsSeries
+	}
+	h.mu.Lock()
+	entries := make([]entry, 0, len(h.series))
+	for k, s := range h.series {
+		entries = append(entries, entry{k, metricsSeries{
+			count:   s.count,
+			errors:  s.errors,
+			buckets: slices.Clone(s.buckets),
+			sum:     s.sum,
+		}})
+	}
+	h.mu.Unlock()
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Or(cmp.Compare(a.key.op, b.key.op), cmp.Compare(a.key.queryName, b.key.queryName))
+	})
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	ns := h.opts.Namespace
+
+	fmt.Fprintf(cw, "# HELP %s_operations_total Number of driver operations.\n", ns)
+	fmt.Fprintf(cw, "# TYPE %s_operations_total counter\n", ns)
+	for _, e := range entries {
+		fmt.Fprintf(cw, "%s_operations_total{%s} %d\n", ns, h.labels(e.key), e.s.count)
+	}
+
+	fmt.Fprintf(cw, "# HELP %s_errors_total Number of driver operations that returned an error.\n", ns)
+	fmt.Fprintf(cw, "# TYPE %s_errors_total counter\n", ns)
+	for _, e := range entries {
+		fmt.Fprintf(cw, "%s_errors_total{%s} %d\n", ns, h.labels(e.key), e.s.errors)
+	}
+
+	fmt.Fprintf(cw, "# HELP %s_operation_duration_seconds Latency of driver operations.\n", ns)
+	fmt.Fprintf(cw, "# TYPE %s_operation_duration_seconds histogram\n", ns)
+	for _, e := range entries {
+		labels := h.labels(e.key)
+		var cumulative uint64
+		for i, le := range h.opts.Buckets {
+			cumulative += e.s.buckets[i]
+			fmt.Fprintf(cw, "%s_operation_duration_seconds_bucket{%s,le=\"%s\"} %d\n",
+				ns, labels, strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(cw, "%s_operation_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", ns, labels, e.s.count)
+		fmt.Fprintf(cw, "%s_operation_duration_seconds_sum{%s} %s\n", ns, labels, strconv.FormatFloat(e.s.sum, 'g', -1, 64))
+		fmt.Fprintf(cw, "%s_operation_duration_seconds_count{%s} %d\n", ns, labels, e.s.count)
+	}
+
+	fmt.Fprintf(cw, "# HELP %s_open_connections Number of open connections.\n", ns)
+	fmt.Fprintf(cw, "# TYPE %s_open_connections gauge\n", ns)
+	fmt.Fprintf(cw, "%s_open_connections %d\n", ns, h.openConns.Load())
+
+	fmt.Fprintf(cw, "# HELP %s_in_flight_queries Number of execs and queries in progress, including rows not yet closed.\n", ns)
+	fmt.Fprintf(cw, "# TYPE %s_in_flight_queries gauge\n", ns)
+	fmt.Fprintf(cw, "%s_in_flight_queries %d\n", ns, h.inFlight.Load())
+
+	return cw.n, cw.flush()
+}
+
+func (h *MetricsHook) labels(k metricsKey) string {
+	if !h.opts.QueryNameLabel {
+		return `op="` + string(k.op) + `"`
+	}
+	return `op="` + string(k.op) + `",query_name="` + escapeLabelValue(k.queryName) + `"`
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueReplacer.Replace(v)
+}
+
+// countingWriter は書き込んだバイト数と最初のエラーを記録する
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+func (cw *countingWriter) flush() error {
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
@@ -0,0 +1,103 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// failingConn は Exec が常にエラーを返すコネクション
+type failingConn struct {
+	legacyConn
+}
+
+func (c *failingConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec failed")
+}
+
+// scrape は handler の出力を系列名 (ラベル込み) から値への map にする
+func scrape(t *testing.T, hook *MetricsHook) map[string]string {
+	t.Helper()
+
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+	res, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("scrape failed: %v", err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+
+	metrics := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(body)), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		metrics[line[:i]] = line[i+1:]
+	}
+	return metrics
+}
+
+// =============================================================================
+// Metrics Tests
+// =============================================================================
+
+func TestMetricsHook(t *testing.T) {
+	hook := NewMetricsHook(&MetricsOptions{QueryNameLabel: true, Buckets: []float64{1, 0.5}})
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &failingConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+	ctx := WithQueryName(context.Background(), "ListUsers")
+
+	if _, err := db.ExecContext(context.Background(), "UPDATE users SET name = ?", "alice"); err == nil {
+		t.Fatalf("expected exec error")
+	}
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+
+	m := scrape(t, hook)
+	for series, want := range map[string]string{
+		`customdriver_operations_total{op="exec",query_name=""}`:                                     "1",
+		`customdriver_errors_total{op="exec",query_name=""}`:                                         "1",
+		`customdriver_operations_total{op="query",query_name="ListUsers"}`:                           "1",
+		`customdriver_errors_total{op="query",query_name="ListUsers"}`:                               "0",
+		`customdriver_operation_duration_seconds_bucket{op="query",query_name="ListUsers",le="0.5"}`: "1",
+		`customdriver_operation_duration_seconds_bucket{op="query",query_name="ListUsers",le="1"}`:   "1",
+		`customdriver_operation_duration_seconds_count{op="query",query_name="ListUsers"}`:           "1",
+		`customdriver_open_connections`:                                                              "1",
+		`customdriver_in_flight_queries`:                                                             "1",
+	} {
+		if got := m[series]; got != want {
+			t.Errorf("%s: expected %s, got %q", series, want, got)
+		}
+	}
+
+	for rows.Next() {
+	}
+	rows.Close()
+	db.Close()
+
+	m = scrape(t, hook)
+	if got := m[`customdriver_in_flight_queries`]; got != "0" {
+		t.Errorf("expected no in-flight queries after rows close, got %s", got)
+	}
+	if got := m[`customdriver_open_connections`]; got != "0" {
+		t.Errorf("expected no open connections after db close, got %s", got)
+	}
+	if _, ok := m[`customdriver_operations_total{op="rows_close",query_name="ListUsers"}`]; ok {
+		t.Errorf("expected rows_close not to be counted as an operation")
+	}
+}
@@ -0,0 +1,106 @@
+package customdriver
+
+import (
+	"database/sql/driver"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+const defaultTxJournalSize = 20
+
+// Option は CustomDriver / CustomConnector の設定を変更する
+type Option func(*config)
+
+// config は CustomDriver / CustomConnector とそこから生成されるラッパーで共有される
+type config struct {
+	hooks      hooks
+	logOptions LogOptions
+	// 組み込みの LogHook の出力先。RunInTx も使う。nil の場合は出力しない
+	logger *slog.Logger
+	// 内部ドライバーから推定したデータベースの種類
+	system string
+	// トランザクションごとに記録するステートメントの上限
+	txJournalSize int
+	// nil でない場合、クエリに sqlcommenter 形式のコメントを付ける
+	commenter *CommenterOptions
+	slowQuery SlowQueryOptions
+	// nil でない場合、Rows / Stmt / Tx のリークを検出する
+	leaks *LeakDetector
+	// nil でない場合、Event.Caller に呼び出し元を設定する
+	callers *callerCache
+	// true の場合、sqlc の :one のクエリが 2 行以上を返していないか確認する
+	oneRowCheck bool
+	// nil でない場合、一時的なエラーで失敗した操作をリトライする
+	retry *RetryOptions
+	// nil でない場合、Connect と Prepare / Begin / Exec / Query / Ping をサーキットブレーカーで保護する
+	breaker *Breaker
+
+	connSeq atomic.Uint64
+	stmtSeq atomic.Uint64
+	txSeq   atomic.Uint64
+}
+
+func newConfig(drv driver.Driver, logger *slog.Logger, opts []Option) *config {
+	cfg := &config{
+		system:        dbSystem(drv),
+		logger:        logger,
+		txJournalSize: defaultTxJournalSize,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	// logger が指定された場合は組み込みの LogHook をチェーンの先頭に置く
+	if logger != nil {
+		cfg.hooks = append(hooks{NewLogHook(logger, &cfg.logOptions)}, cfg.hooks...)
+	}
+	// 遅いクエリの判定は他のフックの After より先に行う
+	if cfg.slowQuery.Threshold > 0 || len(cfg.slowQuery.Thresholds) > 0 {
+		slow := newSlowQueryHook(cfg.slowQuery, cfg.system)
+		cfg.hooks = append(cfg.hooks, slow)
+		slow.hooks = cfg.hooks
+	}
+	return cfg
+}
+
+// dbSystem は内部ドライバーのパッケージからデータベースの種類を推定する。
+// OpenTelemetry の db.system の値 (mysql, postgresql) を返し、不明な場合は空文字列を返す
+func dbSystem(drv driver.Driver) string {
+	if drv == nil {
+		return ""
+	}
+	t := reflect.TypeOf(drv)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch pkg := t.PkgPath(); {
+	case strings.HasPrefix(pkg, "github.com/go-sql-driver/mysql"):
+		return "mysql"
+	case strings.HasPrefix(pkg, "github.com/lib/pq"), strings.HasPrefix(pkg, "github.com/jackc/pgx"):
+		return "postgresql"
+	}
+	return ""
+}
+
+// WithHooks はフックをチェーンの末尾に登録順で追加する
+func WithHooks(hs ...Hook) Option {
+	return func(cfg *config) {
+		cfg.hooks = append(cfg.hooks, hs...)
+	}
+}
+
+// WithLogOptions は logger から作られる組み込みの LogHook の設定を指定する
+func WithLogOptions(opts LogOptions) Option {
+	return func(cfg *config) {
+		cfg.logOptions = opts
+	}
+}
+
+// WithTxJournalSize はトランザクションごとに TxSummary.Journal に残すステートメントの上限を指定する。
+// 上限を超えた場合は古いものから捨てる。0 以下の場合は記録しない
+func WithTxJournalSize(n int) Option {
+	return func(cfg *config) {
+		cfg.txJournalSize = n
+	}
+}
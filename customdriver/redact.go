@@ -0,0 +1,136 @@
+package customdriver
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+const redacted = "[REDACTED]"
+
+// BytesEncoding は ArgPolicy で []byte を出力するときの形式
+type BytesEncoding int
+
+const (
+	BytesHex BytesEncoding = iota
+	BytesBase64
+)
+
+// ArgPolicy は LogHook が引数の値をどう出力するかを決める。
+// ゼロ値の場合は値をそのまま出力する
+type ArgPolicy struct {
+	// true の場合、値を出力せず型名だけを出力する
+	TypesOnly bool
+
+	// sql.Named の名前がいずれかに一致する引数を伏せる
+	Names []string
+	// WithQueryName の名前ごとに、伏せる引数の位置 (1 始まり)
+	Positions map[string][]int
+	// 値の文字列表現がいずれかに一致する引数を伏せる
+	Patterns []*regexp.Regexp
+	// 値の型がいずれかに一致する引数を伏せる。
+	// 値は NamedValueChecker で変換された後の型のため、多くのドライバーでは driver.Value の型になる
+	Types []reflect.Type
+
+	// 0 より大きい場合、これより長い string と []byte を切り詰めて長さを付ける
+	MaxLen int
+	// []byte の出力形式。既定は 16 進数
+	BytesEncoding BytesEncoding
+}
+
+func (p *ArgPolicy) isZero() bool {
+	return !p.TypesOnly && len(p.Names) == 0 && len(p.Positions) == 0 && len(p.Patterns) == 0 &&
+		len(p.Types) == 0 && p.MaxLen <= 0 && p.BytesEncoding == BytesHex
+}
+
+// argsValue は引数を出力用に変換する。redactAll の場合は引数全体を伏せる
+func argsValue(args []driver.NamedValue, queryName string, redactAll bool, p *ArgPolicy) any {
+	if redactAll {
+		return redacted
+	}
+	if p.isZero() {
+		return args
+	}
+
+	out := make([]driver.NamedValue, len(args))
+	positions := p.Positions[queryName]
+	for i, nv := range args {
+		out[i] = nv
+		switch {
+		case p.TypesOnly:
+			out[i].Value = fmt.Sprintf("%T", nv.Value)
+		case p.redacts(nv, positions):
+			out[i].Value = redacted
+		default:
+			out[i].Value = p.truncate(nv.Value)
+		}
+	}
+	return out
+}
+
+func (p *ArgPolicy) redacts(nv driver.NamedValue, positions []int) bool {
+	if nv.Name != "" && slices.Contains(p.Names, nv.Name) {
+		return true
+	}
+	if slices.Contains(positions, nv.Ordinal) {
+		return true
+	}
+	if len(p.Types) > 0 && nv.Value != nil && slices.Contains(p.Types, reflect.TypeOf(nv.Value)) {
+		return true
+	}
+	if len(p.Patterns) > 0 {
+		var s string
+		switch v := nv.Value.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		default:
+			s = fmt.Sprint(v)
+		}
+		for _, re := range p.Patterns {
+			if re.MatchString(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// truncate は長い string を切り詰め、[]byte を BytesEncoding の文字列にする
+func (p *ArgPolicy) truncate(v any) any {
+	switch v := v.(type) {
+	case string:
+		if p.MaxLen <= 0 || len(v) <= p.MaxLen {
+			return v
+		}
+		// マルチバイト文字の途中で切らない
+		n := p.MaxLen
+		for n > 0 && !utf8.RuneStart(v[n]) {
+			n--
+		}
+		return v[:n] + "...(" + strconv.Itoa(len(v)) + " bytes)"
+	case []byte:
+		b := v
+		if p.MaxLen > 0 && len(b) > p.MaxLen {
+			b = b[:p.MaxLen]
+		}
+		var s string
+		if p.BytesEncoding == BytesBase64 {
+			s = "base64:" + base64.StdEncoding.EncodeToString(b)
+		} else {
+			s = "hex:" + hex.EncodeToString(b)
+		}
+		if len(b) < len(v) {
+			s += "...(" + strconv.Itoa(len(v)) + " bytes)"
+		}
+		return s
+	}
+	return v
+}
@@ -0,0 +1,206 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// loggedArgs は msg のレコードの args の値を順に返す
+func loggedArgs(t *testing.T, records []map[string]any, msg string) [][]any {
+	t.Helper()
+
+	var result [][]any
+	for _, r := range records {
+		if r["msg"] != msg {
+			continue
+		}
+		args, ok := r["args"].([]any)
+		if !ok {
+			t.Fatalf("expected args array in %v", r)
+		}
+		values := make([]any, len(args))
+		for i, a := range args {
+			values[i] = a.(map[string]any)["Value"]
+		}
+		result = append(result, values)
+	}
+	return result
+}
+
+// =============================================================================
+// Argument Policy Tests
+// =============================================================================
+
+func TestArgsValue(t *testing.T) {
+	blob := bytes.Repeat([]byte{0xab}, 100)
+	tests := []struct {
+		name      string
+		policy    ArgPolicy
+		queryName string
+		args      []driver.NamedValue
+		want      []any
+	}{
+		{
+			name:   "zero policy",
+			policy: ArgPolicy{},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: "alice"}},
+			want:   []any{"alice"},
+		},
+		{
+			name:   "types only",
+			policy: ArgPolicy{TypesOnly: true},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: "alice"}, {Ordinal: 2, Value: int64(1)}, {Ordinal: 3, Value: nil}},
+			want:   []any{"string", "int64", "<nil>"},
+		},
+		{
+			name:   "names",
+			policy: ArgPolicy{Names: []string{"password"}},
+			args:   []driver.NamedValue{{Name: "user", Ordinal: 1, Value: "alice"}, {Name: "password", Ordinal: 2, Value: "secret"}},
+			want:   []any{"alice", redacted},
+		},
+		{
+			name:      "positions for query name",
+			policy:    ArgPolicy{Positions: map[string][]int{"CreateUser": {2}}},
+			queryName: "CreateUser",
+			args:      []driver.NamedValue{{Ordinal: 1, Value: "alice"}, {Ordinal: 2, Value: "secret"}},
+			want:      []any{"alice", redacted},
+		},
+		{
+			name:      "positions for other query name",
+			policy:    ArgPolicy{Positions: map[string][]int{"CreateUser": {2}}},
+			queryName: "GetUser",
+			args:      []driver.NamedValue{{Ordinal: 1, Value: "alice"}, {Ordinal: 2, Value: "secret"}},
+			want:      []any{"alice", "secret"},
+		},
+		{
+			name:   "patterns",
+			policy: ArgPolicy{Patterns: []*regexp.Regexp{regexp.MustCompile(`^[^@]+@[^@]+$`)}},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: "alice@example.com"}, {Ordinal: 2, Value: "alice"}},
+			want:   []any{redacted, "alice"},
+		},
+		{
+			name:   "types",
+			policy: ArgPolicy{Types: []reflect.Type{reflect.TypeFor[time.Time]()}},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: time.Unix(0, 0)}, {Ordinal: 2, Value: int64(1)}},
+			want:   []any{redacted, int64(1)},
+		},
+		{
+			name:   "truncate string",
+			policy: ArgPolicy{MaxLen: 4},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: "あいう"}, {Ordinal: 2, Value: "abc"}},
+			want:   []any{"あ...(9 bytes)", "abc"},
+		},
+		{
+			name:   "truncate bytes as hex",
+			policy: ArgPolicy{MaxLen: 2},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: blob}},
+			want:   []any{"hex:abab...(100 bytes)"},
+		},
+		{
+			name:   "bytes as base64",
+			policy: ArgPolicy{BytesEncoding: BytesBase64},
+			args:   []driver.NamedValue{{Ordinal: 1, Value: []byte("hi")}},
+			want:   []any{"base64:aGk="},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := argsValue(tt.args, tt.queryName, false, &tt.policy).([]driver.NamedValue)
+			if !ok {
+				t.Fatalf("expected []driver.NamedValue")
+			}
+			for i, want := range tt.want {
+				if !reflect.DeepEqual(got[i].Value, want) {
+					t.Errorf("arg %d: expected %v, got %v", i+1, want, got[i].Value)
+				}
+			}
+		})
+	}
+
+	if got := argsValue(nil, "", true, &ArgPolicy{}); got != redacted {
+		t.Errorf("expected all args to be redacted, got %v", got)
+	}
+}
+
+func TestArgPolicy_ConnAndStmt(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, logger, WithLogOptions(LogOptions{
+		Args: ArgPolicy{Positions: map[string][]int{"UpdatePassword": {1}}},
+	})))
+	defer db.Close()
+	ctx := WithQueryName(context.Background(), "UpdatePassword")
+
+	if _, err := db.ExecContext(ctx, "UPDATE users SET password = ?", "secret"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	stmt, err := db.PrepareContext(ctx, "UPDATE users SET password = ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	defer stmt.Close()
+	if _, err := stmt.ExecContext(ctx, "secret"); err != nil {
+		t.Fatalf("stmt Exec failed: %v", err)
+	}
+
+	records := decodeLogs(t, &buf)
+	for _, msg := range []string{"sql executed", "stmt executed"} {
+		args := loggedArgs(t, records, msg)
+		if len(args) != 1 {
+			t.Fatalf("expected 1 %q record, got %d", msg, len(args))
+		}
+		if args[0][0] != redacted {
+			t.Errorf("%s: unexpected args %v", msg, args[0])
+		}
+	}
+}
+
+func TestMySQL_ArgPolicyCRUD(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithLogOptions(LogOptions{
+		Args: ArgPolicy{Patterns: []*regexp.Regexp{regexp.MustCompile(`@example\.com$`)}, MaxLen: 8},
+	})))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "alice@example.com"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	long := strings.Repeat("x", 100)
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", long); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	var name string
+	if err := db.QueryRowContext(ctx, "SELECT name FROM users WHERE name = ?", "alice@example.com").Scan(&name); err != nil {
+		t.Fatalf("SELECT failed: %v", err)
+	}
+
+	// MySQL は引数付きの ExecContext / QueryContext で driver.ErrSkip を返すため、プリペアドステートメントを経由する
+	raw := buf.String()
+	records := decodeLogs(t, &buf)
+	var values []any
+	for _, msg := range []string{"stmt executed", "stmt queried"} {
+		for _, args := range loggedArgs(t, records, msg) {
+			values = append(values, args...)
+		}
+	}
+	want := []any{redacted, "xxxxxxxx...(100 bytes)", redacted}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("expected logged args %v, got %v", want, values)
+	}
+	if strings.Contains(raw, "alice@example.com") || strings.Contains(raw, long) {
+		t.Errorf("expected redacted values not to appear in logs")
+	}
+}
@@ -0,0 +1,237 @@
+package customdriver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/lib/pq"
+)
+
+var (
+	_ driver.Driver        = (*registeredDriver)(nil)
+	_ driver.DriverContext = (*registeredDriver)(nil)
+)
+
+func init() {
+	Register("custom+mysql", &mysql.MySQLDriver{}, nil)
+	Register("custom+postgres", &pq.Driver{}, nil)
+	Register("custom+pgx", stdlib.GetDefaultDriver(), nil)
+}
+
+// DSN に埋め込める customdriver 用のオプション。
+// 内部ドライバーに渡す前に DSN から取り除かれる。
+const (
+	// 成功した操作のログレベル (debug, info, warn, error)
+	dsnLogLevel = "custom_log_level"
+	// 遅いクエリとみなす時間 (time.ParseDuration の形式)
+	dsnSlowThreshold = "custom_slow_threshold"
+	// 引数をログに出さない (true / false)
+	dsnRedactArgs = "custom_redact_args"
+	// 成功した操作のログを出力する割合 (0 から 1)
+	dsnSampleRate = "custom_sample_rate"
+	// Exec の影響を受けた行数の上限。超えた場合に警告する
+	dsnMaxRowsAffected = "custom_max_rows_affected"
+
+	dsnOptionPrefix = "custom_"
+)
+
+// Register は drv をラップしたドライバーを name で database/sql に登録する。
+// sql.Open(name, dsn) の dsn に含まれる customdriver 用のオプションは opts より優先される。
+// logger が nil の場合は接続時点の slog.Default() を使う。
+func Register(name string, drv driver.Driver, logger *slog.Logger, opts ...Option) {
+	sql.Register(name, &registeredDriver{
+		driver: drv,
+		logger: logger,
+		opts:   opts,
+	})
+}
+
+// registeredDriver は DSN ごとにオプションを解釈して CustomDriver を組み立てる
+type registeredDriver struct {
+	driver driver.Driver
+	logger *slog.Logger
+	opts   []Option
+}
+
+func (d *registeredDriver) Open(name string) (driver.Conn, error) {
+	cd, dsn, err := d.customDriver(name)
+	if err != nil {
+		return nil, err
+	}
+	return cd.Open(dsn)
+}
+
+func (d *registeredDriver) OpenConnector(name string) (driver.Connector, error) {
+	cd, dsn, err := d.customDriver(name)
+	if err != nil {
+		return nil, err
+	}
+	return cd.OpenConnector(dsn)
+}
+
+func (d *registeredDriver) customDriver(name string) (*CustomDriver, string, error) {
+	dsn, params, err := splitDSNOptions(name)
+	if err != nil {
+		return nil, "", err
+	}
+	dsnOpts, err := parseDSNOptions(params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logger := d.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	opts := append(append([]Option{}, d.opts...), dsnOpts...)
+	return NewCustomDriver(d.driver, logger, opts...), dsn, nil
+}
+
+// splitDSNOptions は DSN から customdriver 用のオプションを取り出し、残りの DSN と合わせて返す。
+// MySQL 形式 (user:pass@tcp(host)/db?k=v)、URL 形式 (postgres://host/db?k=v)、
+// キーバリュー形式 (host=localhost k=v) に対応する。
+func splitDSNOptions(dsn string) (string, map[string]string, error) {
+	params := map[string]string{}
+
+	if strings.Contains(dsn, "://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", nil, fmt.Errorf("customdriver: invalid dsn: %w", err)
+		}
+		q := u.Query()
+		for k, v := range q {
+			if strings.HasPrefix(k, dsnOptionPrefix) {
+				params[k] = v[len(v)-1]
+				q.Del(k)
+			}
+		}
+		u.RawQuery = q.Encode()
+		return u.String(), params, nil
+	}
+
+	// MySQL 形式は最後の '/' より後ろにパラメーターを持つ
+	if slash := strings.LastIndexByte(dsn, '/'); slash >= 0 && !strings.Contains(dsn[:slash], "=") {
+		question := strings.IndexByte(dsn[slash:], '?')
+		if question < 0 {
+			return dsn, params, nil
+		}
+		question += slash
+		var rest []string
+		for _, kv := range strings.Split(dsn[question+1:], "&") {
+			k, v, _ := strings.Cut(kv, "=")
+			if !strings.HasPrefix(k, dsnOptionPrefix) {
+				rest = append(rest, kv)
+				continue
+			}
+			value, err := url.QueryUnescape(v)
+			if err != nil {
+				return "", nil, fmt.Errorf("customdriver: invalid dsn parameter %s: %w", k, err)
+			}
+			params[k] = value
+		}
+		if len(rest) == 0 {
+			return dsn[:question], params, nil
+		}
+		return dsn[:question+1] + strings.Join(rest, "&"), params, nil
+	}
+
+	// キーバリュー形式。値はシングルクォートで囲まれている場合がある
+	var rest []string
+	for _, kv := range splitKeyValueDSN(dsn) {
+		k, v, _ := strings.Cut(kv, "=")
+		k = strings.TrimSpace(k)
+		if !strings.HasPrefix(k, dsnOptionPrefix) {
+			rest = append(rest, kv)
+			continue
+		}
+		params[k] = strings.Trim(strings.TrimSpace(v), "'")
+	}
+	return strings.Join(rest, " "), params, nil
+}
+
+// splitKeyValueDSN はキーバリュー形式の DSN を空白で区切る。クォート内の空白は区切りとみなさない
+func splitKeyValueDSN(dsn string) []string {
+	var fields []string
+	var b strings.Builder
+	quoted, escaped := false, false
+	for _, r := range dsn {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '\'':
+			quoted = !quoted
+		case !quoted && (r == ' ' || r == '\t' || r == '\n'):
+			if b.Len() > 0 {
+				fields = append(fields, b.String())
+				b.Reset()
+			}
+			continue
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() > 0 {
+		fields = append(fields, b.String())
+	}
+	return fields
+}
+
+func parseDSNOptions(params map[string]string) ([]Option, error) {
+	var opts []Option
+	for k, v := range params {
+		switch k {
+		case dsnLogLevel:
+			var level slog.Level
+			if err := level.UnmarshalText([]byte(v)); err != nil {
+				return nil, fmt.Errorf("customdriver: invalid %s: %w", k, err)
+			}
+			opts = append(opts, func(cfg *config) {
+				cfg.logOptions.Level = level
+			})
+		case dsnSlowThreshold:
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("customdriver: invalid %s: %w", k, err)
+			}
+			opts = append(opts, func(cfg *config) {
+				cfg.slowQuery.Threshold = d
+			})
+		case dsnRedactArgs:
+			redact, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("customdriver: invalid %s: %w", k, err)
+			}
+			opts = append(opts, func(cfg *config) {
+				cfg.logOptions.RedactArgs = redact
+			})
+		case dsnSampleRate:
+			rate, err := strconv.ParseFloat(v, 64)
+			if err != nil || rate < 0 || rate > 1 {
+				return nil, fmt.Errorf("customdriver: invalid %s: %q", k, v)
+			}
+			opts = append(opts, func(cfg *config) {
+				cfg.logOptions.SampleRate = rate
+			})
+		case dsnMaxRowsAffected:
+			limit, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || limit < 0 {
+				return nil, fmt.Errorf("customdriver: invalid %s: %q", k, v)
+			}
+			opts = append(opts, func(cfg *config) {
+				cfg.logOptions.MaxRowsAffected = limit
+			})
+		default:
+			return nil, fmt.Errorf("customdriver: unknown dsn option %s", k)
+		}
+	}
+	return opts, nil
+}
@@ -0,0 +1,158 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"testing"
+)
+
+// resultConn は Exec が固定の driver.Result を返すコネクション
+type resultConn struct {
+	legacyConn
+	result driver.Result
+}
+
+func (c *resultConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.result, nil
+}
+
+// insertResult は MySQL のように LastInsertId を返す driver.Result
+type insertResult struct {
+	id, rows int64
+}
+
+func (r insertResult) LastInsertId() (int64, error) { return r.id, nil }
+func (r insertResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+// =============================================================================
+// Exec Result Tests
+// =============================================================================
+
+func TestExecResult_EventAndLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     driver.Result
+		wantRows   int64
+		wantID     int64
+		wantIDAttr bool
+	}{
+		{"last insert id", insertResult{id: 42, rows: 1}, 1, 42, true},
+		// lib/pq と pgx の LastInsertId はエラーを返す
+		{"last insert id not supported", driver.RowsAffected(3), 3, 0, false},
+		{"nil result", nil, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			hook := &recordingHook{}
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+			db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &resultConn{result: tt.result}}, logger, WithHooks(hook)))
+			defer db.Close()
+
+			if _, err := db.Exec("INSERT INTO users (name) VALUES ('alice')"); err != nil {
+				t.Fatalf("Exec failed: %v", err)
+			}
+
+			var found bool
+			for _, e := range hook.events {
+				if e.Op == OpExec {
+					found = true
+					if e.RowsAffected != tt.wantRows || e.LastInsertID != tt.wantID {
+						t.Errorf("expected (%d, %d), got (%d, %d)", tt.wantRows, tt.wantID, e.RowsAffected, e.LastInsertID)
+					}
+				}
+			}
+			if !found {
+				t.Fatalf("expected exec event")
+			}
+			records := decodeLogs(t, &buf)
+			if n := countMessages(records, "too many rows affected"); n != 0 {
+				t.Errorf("expected no warning without limit, got %d", n)
+			}
+			for _, r := range records {
+				if r["msg"] != "sql executed" {
+					continue
+				}
+				if r["rows_affected"] != float64(tt.wantRows) {
+					t.Errorf("expected rows_affected %d, got %v", tt.wantRows, r["rows_affected"])
+				}
+				if _, ok := r["last_insert_id"]; ok != tt.wantIDAttr {
+					t.Errorf("unexpected last_insert_id: %v", r["last_insert_id"])
+				}
+			}
+		})
+	}
+}
+
+func TestExecResult_MaxRowsAffected(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	conn := &resultConn{result: driver.RowsAffected(100)}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, logger, WithLogOptions(LogOptions{MaxRowsAffected: 10})))
+	defer db.Close()
+	ctx := WithQueryName(context.Background(), "DeleteUsers")
+
+	if _, err := db.ExecContext(ctx, "DELETE FROM users"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+	conn.result = driver.RowsAffected(10)
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE id < 10"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "too many rows affected"); n != 1 {
+		t.Fatalf("expected 1 warning, got %d", n)
+	}
+	for _, r := range records {
+		if r["msg"] != "too many rows affected" {
+			continue
+		}
+		if r["level"] != "WARN" || r["query"] != "DELETE FROM users" || r["query_name"] != "DeleteUsers" ||
+			r["rows_affected"] != float64(100) || r["limit"] != float64(10) {
+			t.Errorf("unexpected warning: %v", r)
+		}
+	}
+}
+
+func TestMySQL_ExecResult(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	hook := &recordingHook{}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	result, err := db.Exec("INSERT INTO users (name) VALUES (?), (?)", "alice", "bob")
+	if err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("LastInsertId failed: %v", err)
+	}
+
+	e := hook.events[len(hook.events)-1]
+	if e.Op != OpExec || e.RowsAffected != 2 || e.LastInsertID != id {
+		t.Errorf("expected rows 2 and id %d, got %+v", id, e)
+	}
+}
+
+func TestPostgreSQL_ExecResult(t *testing.T) {
+	truncatePgUsers(t)
+
+	hook := &recordingHook{}
+	db := sql.OpenDB(NewCustomConnector(pgConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT INTO users (name) VALUES ($1), ($2)", "alice", "bob"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+
+	e := hook.events[len(hook.events)-1]
+	if e.Op != OpExec || e.RowsAffected != 2 || e.LastInsertID != 0 {
+		t.Errorf("expected rows 2 without last insert id, got %+v", e)
+	}
+}
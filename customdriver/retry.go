@@ -0,0 +1,178 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 10 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+)
+
+// RetryOptions は一時的なエラーの自動リトライを調整する
+type RetryOptions struct {
+	// 最初の実行を含めた試行回数の上限。0 の場合は 3
+	MaxAttempts int
+	// 0 より大きい場合、最初の試行からこの時間を超えてリトライしない。
+	// context に期限がある場合は、期限までに待ち終わらないリトライもしない
+	MaxElapsed time.Duration
+	// 1 回目のリトライまでの待ち時間。以降は 2 倍ずつ MaxBackoff まで延ばし、最大で半分を乱数で縮める。0 の場合は 10ms
+	InitialBackoff time.Duration
+	// 0 の場合は 1 秒
+	MaxBackoff time.Duration
+	// リトライするエラーの判定。nil の場合は IsTransient を使う
+	Retryable func(error) bool
+}
+
+// WithRetry は一時的なエラーで失敗した操作の自動リトライを有効にする。
+//
+// リトライするのはトランザクション外の読み取りのクエリ (SELECT / SHOW / EXPLAIN など) と Ping / Connect で、
+// Exec と書き込みのクエリは WithIdempotent を指定した場合のみリトライする。
+// WithoutRetry を指定した操作はリトライしない。
+// Query は内部ドライバーが Rows を返すまでをリトライし、行の読み取り中のエラーはリトライしない。
+//
+// 試行ごとにフックが呼ばれ、Event.Attempt に試行回数が設定される。
+// Connect 以外の操作でコネクションが切れた場合は、新しいコネクションを開いて置き換えてから再実行する。
+// 再接続も MaxAttempts と MaxElapsed の範囲で行い、失敗した場合はリトライを止めて元のエラーを返す。
+// 置き換えたコネクションではセッションの状態 (SET した変数や一時テーブルなど) は引き継がれない
+func WithRetry(opts RetryOptions) Option {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultRetryInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if opts.Retryable == nil {
+		opts.Retryable = IsTransient
+	}
+	return func(cfg *config) {
+		cfg.retry = &opts
+	}
+}
+
+type retryModeKey struct{}
+
+type retryMode int
+
+const (
+	retryDefault retryMode = iota
+	retryNever
+	retryIdempotent
+)
+
+// WithoutRetry は ctx を使って発行された操作をリトライしない
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryModeKey{}, retryNever)
+}
+
+// WithIdempotent は ctx を使って発行された Exec と書き込みのクエリを冪等とみなし、リトライを許可する
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryModeKey{}, retryIdempotent)
+}
+
+// IsTransient は err がリトライで解消する可能性のある一時的なエラーかを返す。
+// コネクションの切断、ロック待ちのタイムアウト、デッドロック (MySQL 1205 / 1213、PostgreSQL 40P01 / 55P03 / 57P01 など) が該当する
+func IsTransient(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	switch Classify(err) {
+	case CategoryConnectionLost, CategoryLockTimeout, CategoryDeadlock:
+		return true
+	}
+	return false
+}
+
+// isReadQuery は query がデータを変更しない読み取りのステートメントかを返す。
+// データを変更する CTE があり得るため WITH は含めない
+func isReadQuery(query string) bool {
+	switch firstKeyword(query) {
+	case "SELECT", "SHOW", "EXPLAIN", "DESCRIBE", "DESC":
+		return true
+	}
+	return false
+}
+
+// retrier は 1 つの操作のリトライの状態
+type retrier struct {
+	opts    *RetryOptions
+	start   time.Time
+	attempt int
+	// 直前の試行の後に待つ時間。0 の場合はリトライしない
+	backoff time.Duration
+}
+
+// newRetrier は ctx で発行された op をリトライできる場合に retrier を返す。リトライしない場合は nil を返す。
+// トランザクション中の操作では呼び出さない
+func (cfg *config) newRetrier(ctx context.Context, op Op, query string) *retrier {
+	if cfg.retry == nil {
+		return nil
+	}
+	mode, _ := ctx.Value(retryModeKey{}).(retryMode)
+	switch mode {
+	case retryNever:
+		return nil
+	case retryDefault:
+		if op == OpExec || (op == OpQuery && !isReadQuery(query)) {
+			return nil
+		}
+	}
+	return &retrier{opts: cfg.retry, start: time.Now()}
+}
+
+// check は試行の結果の err からリトライするかを判定し、e に試行回数と待ち時間を設定する。
+// フックの After より前に呼び出す
+func (r *retrier) check(ctx context.Context, e *Event, err error) {
+	if r == nil {
+		return
+	}
+	r.attempt++
+	r.backoff = 0
+	e.Attempt = r.attempt
+	if err == nil || err == driver.ErrSkip || ctx.Err() != nil || r.attempt >= r.opts.MaxAttempts || !r.opts.Retryable(err) {
+		return
+	}
+	d := jitteredBackoff(r.opts.InitialBackoff, r.opts.MaxBackoff, r.attempt)
+	if r.opts.MaxElapsed > 0 && time.Since(r.start)+d > r.opts.MaxElapsed {
+		return
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= d {
+		return
+	}
+	r.backoff = d
+	e.RetryBackoff = d
+}
+
+// jitteredBackoff は attempt 回目の失敗の後に待つ時間を返す。
+// initial から 2 倍ずつ limit まで延ばし、同時にリトライが集中しないよう最大で半分を乱数で縮める
+func jitteredBackoff(initial, limit time.Duration, attempt int) time.Duration {
+	d := initial << (attempt - 1)
+	if d <= 0 || d > limit {
+		d = limit
+	}
+	return d - rand.N(d/2+1)
+}
+
+// sleep は check がリトライすると判定した場合に待ち時間だけ待ち、リトライする場合は true を返す。
+// 待っている間に ctx が終了した場合は false を返す
+func (r *retrier) sleep(ctx context.Context) bool {
+	if r == nil || r.backoff <= 0 {
+		return false
+	}
+	t := time.NewTimer(r.backoff)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,567 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var errLockWaitTimeout = &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded"}
+
+// flakyConn は最初の failures 回の Exec / Query が err を返すコネクション
+type flakyConn struct {
+	txConn
+	mu       sync.Mutex
+	failures int
+	err      error
+	closed   bool
+}
+
+func (c *flakyConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *flakyConn) fail() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures == 0 {
+		return nil
+	}
+	c.failures--
+	return c.err
+}
+
+func (c *flakyConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	if err := c.fail(); err != nil {
+		return nil, err
+	}
+	return c.txConn.Exec(query, args)
+}
+
+func (c *flakyConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	if err := c.fail(); err != nil {
+		return nil, err
+	}
+	return c.txConn.Query(query, args)
+}
+
+// dialConnector は Connect のたびに conns を順に返し、使い切った後は err を返すコネクター
+type dialConnector struct {
+	staticConnector
+	mu    sync.Mutex
+	conns []driver.Conn
+	dials int
+	err   error
+}
+
+func (c *dialConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dials == len(c.conns) {
+		return nil, c.err
+	}
+	c.dials++
+	return c.conns[c.dials-1], nil
+}
+
+// lostStmtConn は準備した stmt の Exec / Query も flakyConn と同じく失敗させる
+type lostStmtConn struct {
+	flakyConn
+}
+
+func (c *lostStmtConn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.flakyConn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return &lostStmt{Stmt: stmt, conn: c}, nil
+}
+
+type lostStmt struct {
+	driver.Stmt
+	conn *lostStmtConn
+}
+
+func (s *lostStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.conn.fail(); err != nil {
+		return nil, err
+	}
+	return s.Stmt.Query(args)
+}
+
+// flakyConnector は最初の failures 回の Connect が err を返すコネクター
+type flakyConnector struct {
+	staticConnector
+	failures int
+	err      error
+}
+
+func (c *flakyConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, c.err
+	}
+	return c.staticConnector.Connect(ctx)
+}
+
+// flakyDriver は最初の failures 回の Open が err を返すドライバー
+type flakyDriver struct {
+	failures int
+	err      error
+}
+
+func (d *flakyDriver) Open(name string) (driver.Conn, error) {
+	if d.failures > 0 {
+		d.failures--
+		return nil, d.err
+	}
+	return &legacyConn{}, nil
+}
+
+var fastRetry = RetryOptions{InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// attempts は op のイベントの試行回数を順に返す
+func attempts(hook *recordingHook, op Op) []int {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	var result []int
+	for _, e := range hook.events {
+		if e.Op == op {
+			result = append(result, e.Attempt)
+		}
+	}
+	return result
+}
+
+// =============================================================================
+// Retry Tests
+// =============================================================================
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"bad conn", driver.ErrBadConn, true},
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, true},
+		{"mysql lock wait timeout", errLockWaitTimeout, true},
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213}, true},
+		{"pg deadlock", &pgconn.PgError{Code: "40P01"}, true},
+		{"pg admin shutdown", &pgconn.PgError{Code: "57P01"}, true},
+		{"unique violation", &mysql.MySQLError{Number: 1062}, false},
+		{"canceled", context.Canceled, false},
+		{"unknown", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := IsTransient(tt.err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestIsReadQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"SELECT 1", true},
+		{"-- name: GetUser :one\nselect id FROM users", true},
+		{"SHOW TABLES", true},
+		{"INSERT INTO users (name) VALUES ('a') RETURNING id", false},
+		{"WITH d AS (DELETE FROM users RETURNING id) SELECT * FROM d", false},
+	}
+	for _, tt := range tests {
+		if got := isReadQuery(tt.query); got != tt.want {
+			t.Errorf("%q: expected %v, got %v", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestRetry_Read(t *testing.T) {
+	var buf bytes.Buffer
+	hook := &recordingHook{}
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	conn := &flakyConn{failures: 2, err: errLockWaitTimeout}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, logger, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	rows.Close()
+
+	if got := attempts(hook, OpQuery); len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("expected attempts [1 2 3], got %v", got)
+	}
+	var retries []float64
+	for _, r := range decodeLogs(t, &buf) {
+		switch r["msg"] {
+		case "retrying after transient error":
+			if r["level"] != "WARN" || r["error_category"] != "lock_timeout" || r["backoff"] == nil {
+				t.Errorf("unexpected retry record: %v", r)
+			}
+			retries = append(retries, r["attempt"].(float64))
+		case "sql queried":
+			if r["attempt"] != float64(3) {
+				t.Errorf("expected attempt 3 in success record, got %v", r["attempt"])
+			}
+		}
+	}
+	if len(retries) != 2 || retries[0] != 1 || retries[1] != 2 {
+		t.Errorf("expected retries logged with attempts [1 2], got %v", retries)
+	}
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	hook := &recordingHook{}
+	conn := &flakyConn{failures: 5, err: errLockWaitTimeout}
+	opts := fastRetry
+	opts.MaxAttempts = 2
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook), WithRetry(opts)))
+	defer db.Close()
+
+	_, err := db.QueryContext(context.Background(), "SELECT id, name FROM users")
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != 1205 {
+		t.Fatalf("expected lock wait timeout, got %v", err)
+	}
+	if got := attempts(hook, OpQuery); len(got) != 2 {
+		t.Errorf("expected 2 attempts, got %v", got)
+	}
+	hook.mu.Lock()
+	last := hook.events[len(hook.events)-1]
+	hook.mu.Unlock()
+	if last.RetryBackoff != 0 {
+		t.Errorf("expected no backoff on last attempt, got %v", last.RetryBackoff)
+	}
+}
+
+func TestRetry_NotRetried(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		run  func(ctx context.Context, db *sql.DB) error
+	}{
+		{"write", context.Background(), errLockWaitTimeout, func(ctx context.Context, db *sql.DB) error {
+			_, err := db.ExecContext(ctx, "UPDATE users SET name = 'bob'")
+			return err
+		}},
+		{"write query", context.Background(), errLockWaitTimeout, func(ctx context.Context, db *sql.DB) error {
+			_, err := db.QueryContext(ctx, "INSERT INTO users (name) VALUES ('bob') RETURNING id")
+			return err
+		}},
+		{"opt out", WithoutRetry(context.Background()), errLockWaitTimeout, func(ctx context.Context, db *sql.DB) error {
+			_, err := db.QueryContext(ctx, "SELECT id, name FROM users")
+			return err
+		}},
+		{"not transient", context.Background(), &mysql.MySQLError{Number: 1064}, func(ctx context.Context, db *sql.DB) error {
+			_, err := db.QueryContext(ctx, "SELEC id FROM users")
+			return err
+		}},
+		{"in transaction", context.Background(), errLockWaitTimeout, func(ctx context.Context, db *sql.DB) error {
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return err
+			}
+			defer tx.Rollback()
+			_, err = tx.QueryContext(ctx, "SELECT id, name FROM users")
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &recordingHook{}
+			conn := &flakyConn{failures: 1, err: tt.err}
+			db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook), WithRetry(fastRetry)))
+			defer db.Close()
+
+			if err := tt.run(tt.ctx, db); err == nil {
+				t.Fatalf("expected error")
+			}
+			hook.mu.Lock()
+			defer hook.mu.Unlock()
+			for _, e := range hook.events {
+				if (e.Op == OpExec || e.Op == OpQuery) && e.RetryBackoff != 0 {
+					t.Errorf("expected no retry, got %+v", e)
+				}
+			}
+		})
+	}
+}
+
+func TestRetry_IdempotentWrite(t *testing.T) {
+	hook := &recordingHook{}
+	conn := &flakyConn{failures: 1, err: errLockWaitTimeout}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	if _, err := db.ExecContext(WithIdempotent(context.Background()), "UPDATE users SET name = 'bob' WHERE id = 1"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	if got := attempts(hook, OpExec); len(got) != 2 || got[1] != 2 {
+		t.Errorf("expected attempts [1 2], got %v", got)
+	}
+}
+
+func TestRetry_ContextDeadline(t *testing.T) {
+	hook := &recordingHook{}
+	conn := &flakyConn{failures: 1, err: errLockWaitTimeout}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook),
+		WithRetry(RetryOptions{InitialBackoff: time.Minute, MaxBackoff: time.Minute})))
+	defer db.Close()
+
+	// 待ち時間が期限を超えるためリトライしない
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := db.QueryContext(ctx, "SELECT id, name FROM users"); err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := attempts(hook, OpQuery); len(got) != 1 {
+		t.Errorf("expected 1 attempt, got %v", got)
+	}
+}
+
+func TestRetry_MaxElapsed(t *testing.T) {
+	hook := &recordingHook{}
+	conn := &flakyConn{failures: 1, err: errLockWaitTimeout}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook),
+		WithRetry(RetryOptions{InitialBackoff: time.Minute, MaxBackoff: time.Minute, MaxElapsed: time.Second})))
+	defer db.Close()
+
+	if _, err := db.QueryContext(context.Background(), "SELECT id, name FROM users"); err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := attempts(hook, OpQuery); len(got) != 1 {
+		t.Errorf("expected 1 attempt, got %v", got)
+	}
+}
+
+func TestRetry_ConnectionLost(t *testing.T) {
+	hook := &recordingHook{}
+	lost := &flakyConn{failures: 1, err: mysql.ErrInvalidConn}
+	connector := &dialConnector{conns: []driver.Conn{lost, &flakyConn{}}}
+	db := sql.OpenDB(NewCustomConnector(connector, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	// 切れたコネクションを新しいコネクションに置き換えて再実行する
+	rows, err := db.QueryContext(context.Background(), "SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	rows.Close()
+	if got := attempts(hook, OpQuery); len(got) != 2 || got[1] != 2 {
+		t.Fatalf("expected attempts [1 2], got %v", got)
+	}
+	if connector.dials != 2 || !lost.closed {
+		t.Errorf("expected the lost connection to be replaced, got %d dials, closed=%v", connector.dials, lost.closed)
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	var queries, closes []Event
+	for _, e := range hook.events {
+		switch e.Op {
+		case OpQuery:
+			queries = append(queries, e)
+		case OpClose:
+			closes = append(closes, e)
+		}
+	}
+	if queries[0].RetryBackoff == 0 || queries[0].Category != CategoryConnectionLost || queries[0].ConnID != 1 {
+		t.Errorf("expected connection_lost on conn 1 with retry, got %+v", queries[0])
+	}
+	if queries[1].ConnID != 2 {
+		t.Errorf("expected the retry on conn 2, got %+v", queries[1])
+	}
+	if len(closes) != 1 || closes[0].ConnID != 1 {
+		t.Errorf("expected conn 1 to be closed, got %+v", closes)
+	}
+}
+
+func TestRetry_ConnectionLostReconnectFails(t *testing.T) {
+	hook := &recordingHook{}
+	connector := &dialConnector{conns: []driver.Conn{&flakyConn{failures: 1, err: mysql.ErrInvalidConn}}, err: errConnRefused}
+	db := sql.OpenDB(NewCustomConnector(connector, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	// 再接続に失敗した場合はリトライを止め、元のエラーを返す
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.QueryContext(context.Background(), "SELECT id, name FROM users"); !errors.Is(err, mysql.ErrInvalidConn) {
+		t.Fatalf("expected the connection error, got %v", err)
+	}
+	if got := attempts(hook, OpQuery); len(got) != 1 {
+		t.Errorf("expected 1 attempt, got %v", got)
+	}
+	if got := attempts(hook, OpConnect); len(got) != 2 {
+		t.Errorf("expected 1 reconnect, got %v", got)
+	}
+}
+
+func TestRetry_ConnectionLostStmt(t *testing.T) {
+	hook := &recordingHook{}
+	lost := &lostStmtConn{flakyConn: flakyConn{failures: 1, err: mysql.ErrInvalidConn}}
+	fresh := &lostStmtConn{}
+	connector := &dialConnector{conns: []driver.Conn{lost, fresh}}
+	db := sql.OpenDB(NewCustomConnector(connector, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	defer conn.Close()
+	stmt, err := conn.PrepareContext(context.Background(), "SELECT id, name FROM users WHERE id = ?")
+	if err != nil {
+		t.Fatalf("PrepareContext failed: %v", err)
+	}
+	defer stmt.Close()
+
+	// 置き換えたコネクションで stmt を準備し直して再実行する
+	rows, err := stmt.QueryContext(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	rows.Close()
+	if got := attempts(hook, OpQuery); len(got) != 2 || got[1] != 2 {
+		t.Errorf("expected attempts [1 2], got %v", got)
+	}
+	if lost.prepares != 1 || fresh.prepares != 1 {
+		t.Errorf("expected the statement to be prepared again, got %d and %d", lost.prepares, fresh.prepares)
+	}
+	if e, _ := hook.find(OpPrepare); e.StmtID != 1 {
+		t.Errorf("expected stmt 1, got %+v", e)
+	}
+}
+
+func TestRetry_DriverOpen(t *testing.T) {
+	hook := &recordingHook{}
+	drv := NewCustomDriver(&flakyDriver{failures: 2, err: errConnRefused}, nil, WithHooks(hook), WithRetry(fastRetry))
+
+	// DriverContext を持たないドライバーの Open も CustomConnector と同じくリトライする
+	conn, err := drv.Open("dsn")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	conn.Close()
+	if got := attempts(hook, OpConnect); len(got) != 3 || got[2] != 3 {
+		t.Errorf("expected attempts [1 2 3], got %v", got)
+	}
+}
+
+func TestRetry_LegacyQuery(t *testing.T) {
+	hook := &recordingHook{}
+	conn := &flakyConn{failures: 1, err: errLockWaitTimeout}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	c, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	defer c.Close()
+	// Raw で直接呼び出した Query もリトライする
+	err = c.Raw(func(dc any) error {
+		rows, err := dc.(driver.Queryer).Query("SELECT id, name FROM users", nil)
+		if err != nil {
+			return err
+		}
+		return rows.Close()
+	})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if got := attempts(hook, OpQuery); len(got) != 2 || got[1] != 2 {
+		t.Errorf("expected attempts [1 2], got %v", got)
+	}
+}
+
+func TestRetry_Connect(t *testing.T) {
+	hook := &recordingHook{}
+	connector := &flakyConnector{
+		staticConnector: staticConnector{conn: &legacyConn{}},
+		failures:        2,
+		err:             &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+	}
+	db := sql.OpenDB(NewCustomConnector(connector, nil, WithHooks(hook), WithRetry(fastRetry)))
+	defer db.Close()
+
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM users"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	if got := attempts(hook, OpConnect); len(got) != 3 || got[2] != 3 {
+		t.Errorf("expected attempts [1 2 3], got %v", got)
+	}
+	// 失敗した接続には識別子を振らない
+	var ids []uint64
+	for _, e := range hook.events {
+		if e.Op == OpConnect {
+			ids = append(ids, e.ConnID)
+		}
+	}
+	if fmt.Sprint(ids) != "[0 0 1]" {
+		t.Errorf("expected conn ids [0 0 1], got %v", ids)
+	}
+}
+
+func TestMySQL_RetryLockWaitTimeout(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	hook := &recordingHook{}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook), WithRetry(RetryOptions{InitialBackoff: 500 * time.Millisecond})))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (id, name) VALUES (1, 'alice')"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	// 別のトランザクションで行をロックし、ロック待ちを 1 秒でタイムアウトさせる
+	locker, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if _, err := locker.ExecContext(ctx, "UPDATE users SET name = 'bob' WHERE id = 1"); err != nil {
+		t.Fatalf("UPDATE failed: %v", err)
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SET SESSION innodb_lock_wait_timeout = 1"); err != nil {
+		t.Fatalf("SET failed: %v", err)
+	}
+	go func() {
+		time.Sleep(1500 * time.Millisecond)
+		locker.Rollback()
+	}()
+
+	rows, err := conn.QueryContext(ctx, "SELECT id FROM users WHERE id = 1 FOR UPDATE")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	rows.Close()
+	if got := attempts(hook, OpQuery); len(got) < 2 {
+		t.Errorf("expected the query to be retried, got %v", got)
+	}
+}
@@ -0,0 +1,138 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"time"
+)
+
+var (
+	_ driver.Rows                           = (*customRows)(nil)
+	_ driver.RowsNextResultSet              = (*customRows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*customRows)(nil)
+	_ driver.RowsColumnTypeLength           = (*customRows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*customRows)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*customRows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*customRows)(nil)
+)
+
+// customRows は行の読み出しを計測し、Close 時に OpRowsClose をフックへ通知する。
+// customConn と同様に wrapRows で内部の Rows と同じインターフェースだけを公開する
+type customRows struct {
+	rows driver.Rows
+	cfg  *config
+	// クエリの Before が返した context と、クエリのイベント
+	ctx   context.Context
+	query *Event
+	// クエリがトランザクション中に実行された場合のトランザクション
+	tx *customTx
+	// LeakDetector に記録した ID
+	leakID uint64
+
+	count    int64
+	firstRow time.Duration
+	nextErr  error
+}
+
+func newCustomRows(ctx context.Context, conn *customConn, e *Event, rows driver.Rows) driver.Rows {
+	return wrapRows(&customRows{
+		rows:   rows,
+		cfg:    conn.cfg,
+		ctx:    ctx,
+		query:  e,
+		tx:     conn.tx,
+		leakID: conn.cfg.leaks.track(ObjectRows, conn.id, e.Query),
+	})
+}
+
+func (r *customRows) Columns() []string {
+	return r.rows.Columns()
+}
+
+func (r *customRows) Close() error {
+	e := &Event{
+		Op:        OpRowsClose,
+		DBSystem:  r.query.DBSystem,
+		ConnID:    r.query.ConnID,
+		StmtID:    r.query.StmtID,
+		TxID:      r.query.TxID,
+		Query:     r.query.Query,
+		QueryName: r.query.QueryName,
+		Command:   r.query.Command,
+		Args:      r.query.Args,
+		Stmt:      r.query.Stmt,
+		Start:     r.query.Start,
+		Caller:    r.query.Caller,
+		connect:   r.query.connect,
+	}
+	if r.cfg.oneRowCheck && e.Command == "one" {
+		e.MultipleRows = r.count > 1 || (r.count == 1 && r.hasMoreRows())
+	}
+	ctx := r.cfg.hooks.before(r.ctx, e)
+	err := r.rows.Close()
+	r.cfg.leaks.untrack(r.leakID)
+	e.RowsRead = r.count
+	e.FirstRow = r.firstRow
+	r.cfg.hooks.after(ctx, e, errors.Join(r.nextErr, err))
+	if r.tx != nil {
+		// 結果の読み出し中もステートメントを実行中とみなす
+		r.tx.addBusy(e.Duration - r.query.Duration)
+	}
+
+	return err
+}
+
+// hasMoreRows は読み出されていない行が残っているかを返す。読み出した行は数えない
+func (r *customRows) hasMoreRows() bool {
+	if r.nextErr != nil {
+		return false
+	}
+	dest := make([]driver.Value, len(r.rows.Columns()))
+	return r.rows.Next(dest) == nil
+}
+
+func (r *customRows) Next(dest []driver.Value) error {
+	err := r.rows.Next(dest)
+	switch {
+	case err == nil:
+		r.count++
+		if r.count == 1 {
+			r.firstRow = time.Since(r.query.Start)
+		}
+	case err != io.EOF && r.nextErr == nil:
+		r.nextErr = err
+	}
+
+	return err
+}
+
+func (r *customRows) HasNextResultSet() bool {
+	return r.rows.(driver.RowsNextResultSet).HasNextResultSet()
+}
+
+func (r *customRows) NextResultSet() error {
+	return r.rows.(driver.RowsNextResultSet).NextResultSet()
+}
+
+func (r *customRows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.rows.(driver.RowsColumnTypeDatabaseTypeName).ColumnTypeDatabaseTypeName(index)
+}
+
+func (r *customRows) ColumnTypeLength(index int) (int64, bool) {
+	return r.rows.(driver.RowsColumnTypeLength).ColumnTypeLength(index)
+}
+
+func (r *customRows) ColumnTypeNullable(index int) (bool, bool) {
+	return r.rows.(driver.RowsColumnTypeNullable).ColumnTypeNullable(index)
+}
+
+func (r *customRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return r.rows.(driver.RowsColumnTypePrecisionScale).ColumnTypePrecisionScale(index)
+}
+
+func (r *customRows) ColumnTypeScanType(index int) reflect.Type {
+	return r.rows.(driver.RowsColumnTypeScanType).ColumnTypeScanType(index)
+}
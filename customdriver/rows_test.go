@@ -0,0 +1,104 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+// =============================================================================
+// Rows Tests
+// =============================================================================
+
+func TestMySQL_RowsColumnTypes(t *testing.T) {
+	truncateMySQLUsers(t)
+	ctx := context.Background()
+
+	query := "SELECT id, name, created_at FROM users"
+	want := columnTypeNames(t, rawMySQLDB, query)
+	got := columnTypeNames(t, testMySQLDB, query)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d column types, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("column %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+
+	hook := &recordingHook{name: "rows"}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	for _, name := range []string{"rows-1", "rows-2", "rows-3"} {
+		if _, err := testMySQLDB.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", name); err != nil {
+			t.Fatalf("INSERT failed: %v", err)
+		}
+	}
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users ORDER BY id")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	for rows.Next() {
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatalf("rows.Close failed: %v", err)
+	}
+
+	e, ok := hook.find(OpRowsClose)
+	if !ok {
+		t.Fatalf("expected rows_close event, got %v", hook.ops())
+	}
+	if e.RowsRead != 3 {
+		t.Errorf("expected 3 rows read, got %d", e.RowsRead)
+	}
+	if e.FirstRow <= 0 || e.FirstRow > e.Duration {
+		t.Errorf("unexpected first row %v for duration %v", e.FirstRow, e.Duration)
+	}
+}
+
+func TestPostgreSQL_RowsNextResultSet(t *testing.T) {
+	ctx := context.Background()
+
+	rows, err := testPgDB.QueryContext(ctx, "SELECT 1; SELECT 2, 3")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	defer rows.Close()
+
+	var sets int
+	for {
+		for rows.Next() {
+		}
+		sets++
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("rows iteration error: %v", err)
+	}
+	if sets != 2 {
+		t.Errorf("expected 2 result sets, got %d", sets)
+	}
+}
+
+func columnTypeNames(t *testing.T, db *sql.DB, query string) []string {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatalf("ColumnTypes failed: %v", err)
+	}
+	names := make([]string, len(types))
+	for i, ct := range types {
+		names[i] = ct.DatabaseTypeName()
+	}
+	return names
+}
@@ -0,0 +1,152 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// RunInTxOptions は RunInTx を調整する
+type RunInTxOptions struct {
+	// BeginTx に渡すオプション
+	TxOptions *sql.TxOptions
+	// 最初の実行を含めた試行回数の上限。0 の場合は 3
+	MaxAttempts int
+	// 1 回目のリトライまでの待ち時間。以降は 2 倍ずつ MaxBackoff まで延ばし、最大で半分を乱数で縮める。0 の場合は 10ms
+	InitialBackoff time.Duration
+	// 0 の場合は 1 秒
+	MaxBackoff time.Duration
+	// nil でない場合、リトライの前に呼び出す。attempt は失敗した試行の回数、err はその原因
+	OnRetry func(ctx context.Context, attempt int, err error, backoff time.Duration)
+}
+
+// RunInTx は fn をトランザクション内で実行し、fn が nil を返した場合はコミット、エラーを返した場合はロールバックする。
+//
+// fn のステートメントまたはコミットが直列化の失敗やデッドロック
+// (PostgreSQL 40001 / 40P01、MySQL 1213 / 1205) で失敗した場合は、トランザクション全体を最初からやり直す。
+// fn は複数回呼ばれることがあるため、トランザクション外への副作用を持たせないこと。
+//
+// db が customdriver のドライバーで開かれ logger が指定されている場合、リトライと最終的な結果をその logger に出力する
+func RunInTx(ctx context.Context, db *sql.DB, opts *RunInTxOptions, fn func(tx *sql.Tx) error) error {
+	var o RunInTxOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = defaultRetryInitialBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = defaultRetryMaxBackoff
+	}
+	var logger *slog.Logger
+	level := slog.LevelInfo
+	if d, ok := db.Driver().(*CustomDriver); ok && d.cfg.logger != nil {
+		logger = d.cfg.logger
+		if d.cfg.logOptions.Level != nil {
+			level = d.cfg.logOptions.Level.Level()
+		}
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := runTxOnce(ctx, db, o.TxOptions, fn)
+		if err == nil {
+			if logger != nil {
+				logger.LogAttrs(ctx, level, "transaction run completed", runTxAttrs(ctx, attempt, start)...)
+			}
+			return nil
+		}
+
+		var backoff time.Duration
+		if attempt < o.MaxAttempts && isTxConflict(err) && ctx.Err() == nil {
+			backoff = jitteredBackoff(o.InitialBackoff, o.MaxBackoff, attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+				backoff = 0
+			}
+		}
+		if backoff == 0 {
+			if logger != nil {
+				attrs := append(runTxAttrs(ctx, attempt, start),
+					slog.Any("error", err),
+					slog.String("error_category", string(Classify(err))),
+				)
+				logger.LogAttrs(ctx, slog.LevelError, "transaction run failed", attrs...)
+			}
+			return err
+		}
+
+		if logger != nil {
+			attrs := append(runTxAttrs(ctx, attempt, start),
+				slog.Any("error", err),
+				slog.String("error_category", string(Classify(err))),
+				slog.Duration("backoff", backoff),
+			)
+			logger.LogAttrs(ctx, slog.LevelWarn, "retrying transaction", attrs...)
+		}
+		if o.OnRetry != nil {
+			o.OnRetry(ctx, attempt, err, backoff)
+		}
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+// runTxOnce は fn を 1 回だけトランザクション内で実行する。fn が panic した場合もロールバックする
+func runTxOnce(ctx context.Context, db *sql.DB, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	committed = true
+	return tx.Commit()
+}
+
+func runTxAttrs(ctx context.Context, attempt int, start time.Time) []slog.Attr {
+	return append(slices.Clip(AttrsFromContext(ctx)),
+		slog.Int("attempts", attempt),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
+
+// isTxConflict は err がトランザクションをやり直せば成功する可能性のある競合かを返す
+func isTxConflict(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// ER_LOCK_DEADLOCK, ER_LOCK_WAIT_TIMEOUT
+		return mysqlErr.Number == 1213 || mysqlErr.Number == 1205
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "40001" || pqErr.Code == "40P01"
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "40001" || pgErr.Code == "40P01"
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+// conflictConn は最初の failures 回のコミットが err を返すコネクション
+type conflictConn struct {
+	txConn
+	failures  int
+	err       error
+	rollbacks int
+}
+
+func (c *conflictConn) Begin() (driver.Tx, error) {
+	return &conflictTx{conn: c}, nil
+}
+
+type conflictTx struct {
+	conn *conflictConn
+}
+
+func (t *conflictTx) Commit() error {
+	if t.conn.failures > 0 {
+		t.conn.failures--
+		return t.conn.err
+	}
+	return nil
+}
+
+func (t *conflictTx) Rollback() error {
+	t.conn.rollbacks++
+	return nil
+}
+
+var fastRunInTx = RunInTxOptions{InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// =============================================================================
+// RunInTx Tests
+// =============================================================================
+
+func TestIsTxConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213}, true},
+		{"mysql lock wait timeout", &mysql.MySQLError{Number: 1205}, true},
+		{"pq serialization failure", &pq.Error{Code: "40001"}, true},
+		{"pq deadlock", &pq.Error{Code: "40P01"}, true},
+		{"mysql duplicate entry", &mysql.MySQLError{Number: 1062}, false},
+		{"bad conn", driver.ErrBadConn, false},
+		{"application error", errors.New("insufficient balance"), false},
+	}
+	for _, tt := range tests {
+		if got := isTxConflict(tt.err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestRunInTx_RetryCommit(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	conn := &conflictConn{failures: 2, err: &pq.Error{Code: "40001"}}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, logger))
+	defer db.Close()
+
+	var calls int
+	var retries []int
+	opts := fastRunInTx
+	opts.OnRetry = func(ctx context.Context, attempt int, err error, backoff time.Duration) {
+		if Classify(err) != CategorySerializationFailure || backoff <= 0 {
+			t.Errorf("unexpected retry: %v %v", err, backoff)
+		}
+		retries = append(retries, attempt)
+	}
+	ctx := WithAttrs(context.Background(), slog.String("request_id", "req-1"))
+	err := RunInTx(ctx, db, &opts, func(tx *sql.Tx) error {
+		calls++
+		_, err := tx.Exec("UPDATE accounts SET balance = balance - 1")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("RunInTx failed: %v", err)
+	}
+	if calls != 3 || len(retries) != 2 || retries[0] != 1 || retries[1] != 2 {
+		t.Errorf("expected 3 calls and retries [1 2], got %d %v", calls, retries)
+	}
+
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "retrying transaction"); n != 2 {
+		t.Errorf("expected 2 retry records, got %d", n)
+	}
+	for _, r := range records {
+		if r["msg"] == "transaction run completed" && (r["attempts"] != float64(3) || r["request_id"] != "req-1") {
+			t.Errorf("unexpected outcome record: %v", r)
+		}
+	}
+	if n := countMessages(records, "transaction run completed"); n != 1 {
+		t.Errorf("expected 1 outcome record, got %d", n)
+	}
+}
+
+func TestRunInTx_MaxAttempts(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	conn := &conflictConn{failures: 5, err: &mysql.MySQLError{Number: 1213}}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, logger))
+	defer db.Close()
+
+	opts := fastRunInTx
+	opts.MaxAttempts = 2
+	var calls int
+	err := RunInTx(context.Background(), db, &opts, func(tx *sql.Tx) error {
+		calls++
+		return nil
+	})
+	if Classify(err) != CategoryDeadlock || calls != 2 {
+		t.Fatalf("expected deadlock after 2 calls, got %v after %d", err, calls)
+	}
+	for _, r := range decodeLogs(t, &buf) {
+		if r["msg"] == "transaction run failed" && (r["level"] != "ERROR" || r["attempts"] != float64(2) || r["error_category"] != "deadlock") {
+			t.Errorf("unexpected outcome record: %v", r)
+		}
+	}
+}
+
+func TestRunInTx_NotRetried(t *testing.T) {
+	conn := &conflictConn{}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil))
+	defer db.Close()
+
+	// アプリケーションのエラーはロールバックしてそのまま返す
+	errInsufficient := errors.New("insufficient balance")
+	var calls int
+	err := RunInTx(context.Background(), db, &fastRunInTx, func(tx *sql.Tx) error {
+		calls++
+		return errInsufficient
+	})
+	if !errors.Is(err, errInsufficient) || calls != 1 || conn.rollbacks != 1 {
+		t.Errorf("expected single rolled back call, got %v %d %d", err, calls, conn.rollbacks)
+	}
+}
+
+func TestRunInTx_Panic(t *testing.T) {
+	conn := &conflictConn{}
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: conn}, nil))
+	defer db.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+		if conn.rollbacks != 1 {
+			t.Errorf("expected rollback on panic, got %d", conn.rollbacks)
+		}
+	}()
+	RunInTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+		panic("boom")
+	})
+}
+
+// runDeadlockingTxs は 2 つの RunInTx で行 1, 2 を逆の順に更新してデッドロックを起こし、リトライの回数を返す
+func runDeadlockingTxs(t *testing.T, db *sql.DB, update string) int64 {
+	t.Helper()
+
+	// 1 回目の試行では両方が最初の行をロックするまで待ち合わせる
+	var locked sync.WaitGroup
+	locked.Add(2)
+	both := make(chan struct{})
+	go func() {
+		locked.Wait()
+		close(both)
+	}()
+
+	var retries atomic.Int64
+	opts := &RunInTxOptions{
+		MaxAttempts: 5,
+		OnRetry: func(ctx context.Context, attempt int, err error, backoff time.Duration) {
+			if Classify(err) != CategoryDeadlock {
+				t.Errorf("expected deadlock, got %v", err)
+			}
+			retries.Add(1)
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, order := range [][2]int{{1, 2}, {2, 1}} {
+		wg.Go(func() {
+			first := true
+			errs[i] = RunInTx(ctx, db, opts, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, update, "locked", order[0]); err != nil {
+					return err
+				}
+				if first {
+					first = false
+					locked.Done()
+					<-both
+				}
+				_, err := tx.ExecContext(ctx, update, "updated", order[1])
+				return err
+			})
+		})
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("transaction %d failed: %v", i, err)
+		}
+	}
+	return retries.Load()
+}
+
+func TestMySQL_RunInTxDeadlock(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger))
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob')"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	if n := runDeadlockingTxs(t, db, "UPDATE users SET name = ? WHERE id = ?"); n == 0 {
+		t.Errorf("expected deadlock to be retried")
+	}
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "retrying transaction"); n == 0 {
+		t.Errorf("expected retry records")
+	}
+	if n := countMessages(records, "transaction run completed"); n != 2 {
+		t.Errorf("expected 2 outcome records, got %d", n)
+	}
+}
+
+func TestPostgreSQL_RunInTxDeadlock(t *testing.T) {
+	truncatePgUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(pgConnector, logger))
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob')"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	if n := runDeadlockingTxs(t, db, "UPDATE users SET name = $1 WHERE id = $2"); n == 0 {
+		t.Errorf("expected deadlock to be retried")
+	}
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "transaction run completed"); n != 2 {
+		t.Errorf("expected 2 outcome records, got %d", n)
+	}
+}
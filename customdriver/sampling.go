@@ -0,0 +1,189 @@
+package customdriver
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRateLimitWindow = time.Second
+	samplerCacheSize       = 10000
+)
+
+// sampler は LogHook の成功した操作のレコードを間引く。
+// 操作の種類とクエリのフィンガープリントの組ごとに、
+// SampleRate の割合で決定的にレコードを選び、さらに RateLimitWindow あたり RateLimit 件までに制限する
+type sampler struct {
+	rate   float64
+	limit  int
+	window time.Duration
+	now    func() time.Time
+	// afterFunc は d 後に f を呼び出す。flush は抑制したレコードの集計を出力する
+	afterFunc func(d time.Duration, f func())
+	flush     func()
+
+	fingerprints *fingerprintCache
+
+	mu     sync.Mutex
+	states map[sampleKey]*sampleState
+	// 次に期間の終わった状態を確認する時刻
+	nextSweep time.Time
+	// 抑制したレコードの集計を出力するタイマーが待機中か
+	flushScheduled bool
+	// まだ出力していない抑制したレコードの集計
+	pending []suppressedRecords
+}
+
+// sampleKey はレコードをまとめる単位。
+// Query と Rows の Close のように同じクエリで複数の操作がある場合も、操作ごとに数える
+type sampleKey struct {
+	op          Op
+	fingerprint string
+}
+
+type sampleState struct {
+	// SampleRate の判定に使う、これまでに見たレコードの数
+	seen     uint64
+	lastSeen time.Time
+
+	windowStart time.Time
+	logged      int
+	suppressed  int
+}
+
+// suppressedRecords は RateLimit で出力しなかったレコードの集計
+type suppressedRecords struct {
+	key   sampleKey
+	count int
+}
+
+func newSampler(opts *LogOptions) *sampler {
+	s := &sampler{
+		rate:         opts.SampleRate,
+		limit:        opts.RateLimit,
+		window:       opts.RateLimitWindow,
+		now:          time.Now,
+		afterFunc:    func(d time.Duration, f func()) { time.AfterFunc(d, f) },
+		fingerprints: newFingerprintCache(samplerCacheSize),
+		states:       map[sampleKey]*sampleState{},
+	}
+	if s.window <= 0 {
+		s.window = defaultRateLimitWindow
+	}
+	return s
+}
+
+func (s *sampler) enabled() bool {
+	return (s.rate > 0 && s.rate < 1) || s.limit > 0
+}
+
+// key はレコードをまとめる単位を返す
+func (s *sampler) key(e *Event) sampleKey {
+	if e.Query == "" {
+		return sampleKey{op: e.Op}
+	}
+	return sampleKey{op: e.Op, fingerprint: s.fingerprints.get(e)}
+}
+
+// allow は e のレコードを出力するかを返す
+func (s *sampler) allow(e *Event) bool {
+	key := s.key(e)
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.states[key]
+	if !ok {
+		st = &sampleState{windowStart: now}
+		s.states[key] = st
+	}
+	st.lastSeen = now
+
+	st.seen++
+	if s.rate > 0 && s.rate < 1 {
+		// seen * rate の整数部分が増えたときだけ出力する。最初のレコードは必ず出力される
+		if math.Ceil(float64(st.seen)*s.rate) == math.Ceil(float64(st.seen-1)*s.rate) {
+			return false
+		}
+	}
+	if s.limit <= 0 {
+		return true
+	}
+	if now.Sub(st.windowStart) >= s.window {
+		s.closeWindow(key, st, now)
+	}
+	if st.logged >= s.limit {
+		st.suppressed++
+		s.scheduleFlush(now, st.windowStart.Add(s.window))
+		return false
+	}
+	st.logged++
+	return true
+}
+
+// closeWindow は st の期間を終え、抑制したレコードがあれば次の sweep で返す
+func (s *sampler) closeWindow(key sampleKey, st *sampleState, now time.Time) {
+	if st.suppressed > 0 {
+		s.pending = append(s.pending, suppressedRecords{key: key, count: st.suppressed})
+	}
+	st.windowStart, st.logged, st.suppressed = now, 0, 0
+}
+
+// scheduleFlush は次のレコードを待たずに集計を出力できるよう、at に flush を呼び出す。
+// s.mu を保持して呼び出す
+func (s *sampler) scheduleFlush(now, at time.Time) {
+	if s.flush == nil || s.flushScheduled {
+		return
+	}
+	s.flushScheduled = true
+	s.afterFunc(at.Sub(now), func() {
+		s.mu.Lock()
+		s.flushScheduled = false
+		// 期間の終わった状態をすぐに確認させる
+		s.nextSweep = time.Time{}
+		s.mu.Unlock()
+		s.flush()
+	})
+}
+
+// sweep は期間の終わった集計を返す。
+// 出力のたびとタイマーで呼ばれ、しばらく現れていないフィンガープリントの確認は期間ごとに 1 回だけ行う
+func (s *sampler) sweep() []suppressedRecords {
+	if !s.enabled() {
+		return nil
+	}
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !now.Before(s.nextSweep) {
+		s.nextSweep = now.Add(s.window)
+		var next time.Time
+		for key, st := range s.states {
+			if now.Sub(st.windowStart) >= s.window {
+				s.closeWindow(key, st, now)
+			}
+			if now.Sub(st.lastSeen) >= s.window {
+				delete(s.states, key)
+				continue
+			}
+			// まだ期間の終わっていない抑制があれば、その終わりにもう一度確認する
+			if end := st.windowStart.Add(s.window); st.suppressed > 0 && (next.IsZero() || end.Before(next)) {
+				next = end
+			}
+		}
+		if !next.IsZero() {
+			s.scheduleFlush(now, next)
+		}
+	}
+
+	result := s.pending
+	s.pending = nil
+	slices.SortFunc(result, func(a, b suppressedRecords) int {
+		return cmp.Or(cmp.Compare(a.key.fingerprint, b.key.fingerprint), cmp.Compare(a.key.op, b.key.op))
+	})
+	return result
+}
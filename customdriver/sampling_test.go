@@ -0,0 +1,134 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+// newSampledDB は fake clock を使う LogHook で記録する DB を返す
+func newSampledDB(t *testing.T, conn driver.Conn, opts LogOptions) (*sql.DB, *bytes.Buffer, *fakeClock) {
+	t.Helper()
+
+	db, buf, clock := newFakeClockDB(t, &staticConnector{conn: conn}, func(logger *slog.Logger, clock *fakeClock) Option {
+		hook := NewLogHook(logger, &opts)
+		hook.sampler.now = clock.Now
+		hook.sampler.afterFunc = clock.AfterFunc
+		return WithHooks(hook)
+	})
+	return db, buf, clock
+}
+
+func countMessages(records []map[string]any, msg string) int {
+	var n int
+	for _, r := range records {
+		if r["msg"] == msg {
+			n++
+		}
+	}
+	return n
+}
+
+// =============================================================================
+// Sampling Tests
+// =============================================================================
+
+func TestLogHook_SampleRate(t *testing.T) {
+	db, buf, _ := newSampledDB(t, &legacyConn{}, LogOptions{SampleRate: 0.25})
+	ctx := context.Background()
+
+	for i := range 8 {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("UPDATE users SET name = 'user-%d'", i)); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+
+	var names []string
+	for _, r := range decodeLogs(t, buf) {
+		if r["msg"] == "sql executed" {
+			names = append(names, r["query"].(string))
+		}
+	}
+	// 1 件目と 5 件目だけが出力される
+	want := []string{"UPDATE users SET name = 'user-0'", "UPDATE users SET name = 'user-4'"}
+	if fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, names)
+	}
+}
+
+func TestLogHook_SampleRateQuery(t *testing.T) {
+	db, buf, _ := newSampledDB(t, &legacyConn{}, LogOptions{SampleRate: 0.5})
+
+	for range 4 {
+		var id int
+		var name string
+		if err := db.QueryRow("SELECT id, name FROM users").Scan(&id, &name); err != nil {
+			t.Fatalf("QueryRow failed: %v", err)
+		}
+	}
+
+	// Query と Rows の Close は操作ごとに数えるため、どちらも半分ずつ出力される
+	records := decodeLogs(t, buf)
+	queried, closed := countMessages(records, "sql queried"), countMessages(records, "sql rows closed")
+	if queried != 2 || closed != 2 {
+		t.Errorf("expected 2 queried and 2 closed records, got %d and %d", queried, closed)
+	}
+}
+
+func TestLogHook_SamplingKeepsErrors(t *testing.T) {
+	db, buf, _ := newSampledDB(t, &failingConn{}, LogOptions{SampleRate: 0.01, RateLimit: 1})
+
+	for range 3 {
+		if _, err := db.ExecContext(context.Background(), "UPDATE users SET name = ?", "alice"); err == nil {
+			t.Fatalf("expected exec error")
+		}
+	}
+	if n := countMessages(decodeLogs(t, buf), "sql execution failed"); n != 3 {
+		t.Errorf("expected all 3 errors to be logged, got %d", n)
+	}
+}
+
+func TestLogHook_RateLimit(t *testing.T) {
+	db, buf, clock := newSampledDB(t, &legacyConn{}, LogOptions{RateLimit: 2, RateLimitWindow: time.Second})
+	ctx := context.Background()
+
+	for i := range 5 {
+		if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", i); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+	records := decodeLogs(t, buf)
+	if n := countMessages(records, "sql executed"); n != 2 {
+		t.Errorf("expected 2 records in the first window, got %d", n)
+	}
+	if n := countMessages(records, "similar records suppressed"); n != 0 {
+		t.Errorf("expected no summary before the window ends, got %d", n)
+	}
+
+	// 期間の終わりにタイマーで集計を出力する。次のレコードは待たない
+	clock.Advance(time.Second)
+	records = decodeLogs(t, buf)
+	if len(records) != 1 || records[0]["msg"] != "similar records suppressed" {
+		t.Fatalf("expected suppressed summary, got %v", records)
+	}
+	summary := records[0]
+	if summary["suppressed"] != float64(3) || summary["op"] != "exec" || summary["fingerprint"] != "update users set name = ? where id = ?" {
+		t.Errorf("unexpected summary %v", summary)
+	}
+
+	if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "bob", 1); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	records = decodeLogs(t, buf)
+	if n := countMessages(records, "sql executed"); n != 1 {
+		t.Errorf("expected a record in the new window, got %d", n)
+	}
+	if n := countMessages(records, "similar records suppressed"); n != 0 {
+		t.Errorf("expected the summary to be logged once, got %d", n)
+	}
+}
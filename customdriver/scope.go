@@ -0,0 +1,197 @@
+package customdriver
+
+import (
+	"cmp"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultNPlusOneThreshold  = 3
+	scopeFingerprintCacheSize = 10000
+)
+
+// スコープはコネクターに依存しないため、フィンガープリントのキャッシュはパッケージで共有する
+var scopeFingerprints = newFingerprintCache(scopeFingerprintCacheSize)
+
+// TB は ScopeReport.Check が使う testing.TB の一部
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// ScopeOption は StartScope で始めるスコープの設定を変更する
+type ScopeOption func(*scope)
+
+// WithNPlusOneThreshold は同じフィンガープリントのクエリを異なる引数で何回まで実行してよいかを指定する。
+// 既定は 3
+func WithNPlusOneThreshold(n int) ScopeOption {
+	return func(s *scope) {
+		s.threshold = n
+	}
+}
+
+// RepeatedQuery はスコープ内で異なる引数で繰り返し実行されたクエリ
+type RepeatedQuery struct {
+	Fingerprint string
+	// 最初に実行されたクエリとその名前
+	Query     string
+	QueryName string
+	// 実行回数と、そのうち異なる引数の組み合わせの数
+	Count        int
+	DistinctArgs int
+	// 最初に実行した呼び出し元 (file:line)。database/sql と customdriver、sqlc が生成したコードのフレームは除く
+	CallSite string
+}
+
+// ScopeReport は EndScope で返されるスコープ内のクエリの集計
+type ScopeReport struct {
+	// スコープ内で実行した Exec / Query の数
+	Queries int
+	// しきい値を超えて繰り返されたクエリ。実行回数の多い順
+	NPlusOne []RepeatedQuery
+}
+
+// Err は N+1 クエリがあればその内容のエラーを返す
+func (r *ScopeReport) Err() error {
+	var errs []error
+	for _, q := range r.NPlusOne {
+		errs = append(errs, fmt.Errorf("n+1 query: %q executed %d times with %d distinct args at %s", q.Query, q.Count, q.DistinctArgs, q.CallSite))
+	}
+	return errors.Join(errs...)
+}
+
+// Check は N+1 クエリがあればテストを失敗させる
+func (r *ScopeReport) Check(t TB) {
+	t.Helper()
+	for _, q := range r.NPlusOne {
+		t.Errorf("n+1 query: %q executed %d times with %d distinct args at %s", q.Query, q.Count, q.DistinctArgs, q.CallSite)
+	}
+}
+
+// Warn は N+1 クエリがあれば 1 件ずつ logger に警告を出力する
+func (r *ScopeReport) Warn(ctx context.Context, logger *slog.Logger) {
+	for _, q := range r.NPlusOne {
+		logger.WarnContext(ctx, "n+1 query detected",
+			slog.String("fingerprint", q.Fingerprint),
+			slog.String("query", q.Query),
+			slog.String("query_name", q.QueryName),
+			slog.Int("count", q.Count),
+			slog.Int("distinct_args", q.DistinctArgs),
+			slog.String("call_site", q.CallSite),
+		)
+	}
+}
+
+type scopeKey struct{}
+
+// scope は StartScope から EndScope までに実行したクエリを記録する
+type scope struct {
+	parent    *scope
+	threshold int
+
+	mu      sync.Mutex
+	ended   bool
+	queries int
+	repeats map[string]*scopeQuery
+}
+
+type scopeQuery struct {
+	RepeatedQuery
+	args map[string]struct{}
+}
+
+// StartScope は ctx を使って実行したクエリを記録するスコープを始める。
+// リクエストやテストの単位で呼び出し、EndScope で同じクエリを繰り返す N+1 クエリを検出する。
+// スコープは入れ子にでき、内側のスコープで実行したクエリは外側のスコープにも記録される
+func StartScope(ctx context.Context, opts ...ScopeOption) context.Context {
+	s := &scope{
+		threshold: defaultNPlusOneThreshold,
+		repeats:   map[string]*scopeQuery{},
+	}
+	s.parent, _ = ctx.Value(scopeKey{}).(*scope)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return context.WithValue(ctx, scopeKey{}, s)
+}
+
+// EndScope は ctx のスコープを終えて集計を返す。
+// スコープがない場合は空の ScopeReport を返す
+func EndScope(ctx context.Context) *ScopeReport {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return &ScopeReport{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ended = true
+	r := &ScopeReport{Queries: s.queries}
+	for _, q := range s.repeats {
+		if q.DistinctArgs > s.threshold {
+			r.NPlusOne = append(r.NPlusOne, q.RepeatedQuery)
+		}
+	}
+	slices.SortFunc(r.NPlusOne, func(a, b RepeatedQuery) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Fingerprint, b.Fingerprint))
+	})
+	return r
+}
+
+// recordScope は ctx にスコープがあれば実行したクエリを記録する
+func recordScope(ctx context.Context, e *Event) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return
+	}
+
+	fp := scopeFingerprints.get(e)
+	args := argsKey(e.Args)
+	var site string
+	for ; s != nil; s = s.parent {
+		s.mu.Lock()
+		if !s.ended {
+			s.queries++
+			q, ok := s.repeats[fp]
+			if !ok {
+				// 呼び出し元の取得は重いため、フィンガープリントごとに最初の 1 回だけ行う
+				if site == "" {
+					site = e.Caller.String()
+				}
+				if site == "" {
+					site = defaultCallers.caller().String()
+				}
+				q = &scopeQuery{
+					RepeatedQuery: RepeatedQuery{Fingerprint: fp, Query: e.Query, QueryName: e.QueryName, CallSite: site},
+					args:          map[string]struct{}{},
+				}
+				s.repeats[fp] = q
+			}
+			q.Count++
+			if _, ok := q.args[args]; !ok {
+				q.args[args] = struct{}{}
+				q.DistinctArgs++
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// argsKey は引数の組み合わせを比較するための文字列を返す
+func argsKey(args []driver.NamedValue) string {
+	var b strings.Builder
+	for _, nv := range args {
+		b.WriteString(nv.Name)
+		b.WriteByte('=')
+		fmt.Fprintf(&b, "%T:%v", nv.Value, nv.Value)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
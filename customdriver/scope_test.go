@@ -0,0 +1,149 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/replu/goconmini-sendai-2026/sqlc/mysqlquery"
+)
+
+// recordingTB は Check が報告したエラーを記録する
+type recordingTB struct {
+	errors []string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+// =============================================================================
+// N+1 Scope Tests
+// =============================================================================
+
+func TestScope_NPlusOne(t *testing.T) {
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil))
+	defer db.Close()
+
+	ctx := StartScope(context.Background())
+	for i := range 5 {
+		if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", i); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+	// 同じ引数での繰り返しと、しきい値以下の繰り返しは検出しない
+	for range 5 {
+		if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", 1); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+	for i := range 3 {
+		if _, err := db.ExecContext(ctx, "DELETE FROM posts WHERE id = ?", i); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+	report := EndScope(ctx)
+
+	if report.Queries != 13 {
+		t.Errorf("expected 13 queries, got %d", report.Queries)
+	}
+	if len(report.NPlusOne) != 1 {
+		t.Fatalf("expected 1 n+1 query, got %+v", report.NPlusOne)
+	}
+	q := report.NPlusOne[0]
+	if q.Fingerprint != "update users set name = ? where id = ?" || q.Count != 5 || q.DistinctArgs != 5 {
+		t.Errorf("unexpected n+1 query: %+v", q)
+	}
+	if !strings.Contains(q.CallSite, "scope_test.go:") {
+		t.Errorf("expected call site in scope_test.go, got %q", q.CallSite)
+	}
+
+	var tb recordingTB
+	report.Check(&tb)
+	if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], "executed 5 times") {
+		t.Errorf("unexpected errors: %v", tb.errors)
+	}
+	if report.Err() == nil {
+		t.Errorf("expected error")
+	}
+
+	var buf bytes.Buffer
+	report.Warn(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+	records := decodeLogs(t, &buf)
+	if len(records) != 1 || records[0]["msg"] != "n+1 query detected" || records[0]["count"] != float64(5) {
+		t.Errorf("unexpected records: %v", records)
+	}
+
+	// EndScope の後に実行したクエリは記録しない
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", 2); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	if got := EndScope(ctx).Queries; got != 13 {
+		t.Errorf("expected 13 queries after end, got %d", got)
+	}
+}
+
+func TestScope_Nested(t *testing.T) {
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil))
+	defer db.Close()
+
+	outer := StartScope(context.Background(), WithNPlusOneThreshold(1))
+	inner := StartScope(outer, WithNPlusOneThreshold(10))
+	stmt, err := db.PrepareContext(inner, "SELECT name FROM users WHERE id = ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	defer stmt.Close()
+	for i := range 3 {
+		rows, err := stmt.QueryContext(inner, i)
+		if err != nil {
+			t.Fatalf("stmt Query failed: %v", err)
+		}
+		rows.Close()
+	}
+
+	if r := EndScope(inner); r.Queries != 3 || len(r.NPlusOne) != 0 {
+		t.Errorf("unexpected inner report: %+v", r)
+	}
+	if r := EndScope(outer); r.Queries != 3 || len(r.NPlusOne) != 1 {
+		t.Errorf("unexpected outer report: %+v", r)
+	}
+	if r := EndScope(context.Background()); r.Queries != 0 || r.Err() != nil {
+		t.Errorf("unexpected report without scope: %+v", r)
+	}
+}
+
+func TestMySQL_ScopeNPlusOne(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, nil))
+	defer db.Close()
+	names := []string{"alice", "bob", "carol", "dave"}
+	for _, name := range names {
+		if _, err := db.Exec("INSERT INTO users (name) VALUES (?)", name); err != nil {
+			t.Fatalf("INSERT failed: %v", err)
+		}
+	}
+
+	ctx := StartScope(context.Background())
+	q := mysqlquery.New(db)
+	for _, name := range names {
+		if _, err := q.GetUserByName(ctx, name); err != nil {
+			t.Fatalf("GetUserByName failed: %v", err)
+		}
+	}
+	report := EndScope(ctx)
+
+	if len(report.NPlusOne) != 1 {
+		t.Fatalf("expected 1 n+1 query, got %+v", report.NPlusOne)
+	}
+	if q := report.NPlusOne[0]; q.Count != 4 || q.DistinctArgs != 4 || !strings.Contains(q.CallSite, "scope_test.go:") {
+		t.Errorf("unexpected n+1 query: %+v", q)
+	}
+}
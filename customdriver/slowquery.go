@@ -0,0 +1,242 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ Hook = (*slowQueryHook)(nil)
+)
+
+const (
+	defaultExplainInterval = time.Minute
+	defaultExplainTimeout  = 5 * time.Second
+	// 同時に実行する EXPLAIN の上限。超えた分は実行しない
+	maxConcurrentExplains = 2
+)
+
+// SlowQueryOptions は遅いクエリの検出と実行計画の取得を調整する
+type SlowQueryOptions struct {
+	// 0 より大きい場合、これ以上時間のかかった Exec / Query を遅いクエリとみなす
+	Threshold time.Duration
+	// WithQueryName の名前ごとのしきい値。名前が含まれる場合は Threshold より優先する
+	Thresholds map[string]time.Duration
+
+	// true の場合、遅いクエリと同じクエリ・引数で EXPLAIN を別のコネクションでバックグラウンドに実行し、
+	// 結果を Event.Plan に設定して PlanHook に渡す。MySQL では EXPLAIN FORMAT=JSON、
+	// PostgreSQL では EXPLAIN (FORMAT JSON) を使う
+	Explain bool
+	// true の場合、SELECT 以外のステートメントでも EXPLAIN を実行する
+	ExplainNonSelect bool
+	// 同じクエリの EXPLAIN を再度実行するまでの間隔。0 の場合は 1 分
+	ExplainInterval time.Duration
+	// EXPLAIN の接続と実行のタイムアウト。0 の場合は 5 秒
+	ExplainTimeout time.Duration
+}
+
+// WithSlowQuery は遅いクエリの検出を有効にする。
+// 遅いクエリは Event.SlowThreshold に超えたしきい値が設定され、LogHook は専用の警告を出力する。
+// 実行計画は後から PlanHook に渡され、LogHook は "slow query plan" の警告として出力する。
+// Query はクエリの開始から Rows の Close までの時間で判定する
+func WithSlowQuery(opts SlowQueryOptions) Option {
+	return func(cfg *config) {
+		cfg.slowQuery = opts
+	}
+}
+
+// slowQueryHook は Exec / Rows の Close の後で遅いクエリを判定し、必要なら実行計画を取得する。
+// 他のフックが結果を参照できるよう、チェーンの末尾に置く
+type slowQueryHook struct {
+	opts   SlowQueryOptions
+	system string
+	// 実行計画を渡すフックのチェーン。newConfig で設定される
+	hooks hooks
+	// EXPLAIN を実行中のゴルーチンの数を maxConcurrentExplains までに制限する
+	sem chan struct{}
+
+	// クエリごとに最後に EXPLAIN を実行した時刻
+	mu        sync.Mutex
+	explained map[string]time.Time
+}
+
+func newSlowQueryHook(opts SlowQueryOptions, system string) *slowQueryHook {
+	if opts.ExplainInterval <= 0 {
+		opts.ExplainInterval = defaultExplainInterval
+	}
+	if opts.ExplainTimeout <= 0 {
+		opts.ExplainTimeout = defaultExplainTimeout
+	}
+	return &slowQueryHook{
+		opts:      opts,
+		system:    system,
+		sem:       make(chan struct{}, maxConcurrentExplains),
+		explained: map[string]time.Time{},
+	}
+}
+
+func (h *slowQueryHook) Before(ctx context.Context, e *Event) context.Context {
+	return ctx
+}
+
+func (h *slowQueryHook) After(ctx context.Context, e *Event) {
+	if (e.Op != OpExec && e.Op != OpRowsClose) || e.Err != nil {
+		return
+	}
+	threshold, ok := h.opts.Thresholds[e.QueryName]
+	if !ok || e.QueryName == "" {
+		threshold = h.opts.Threshold
+	}
+	if threshold <= 0 || e.Duration < threshold {
+		return
+	}
+	e.SlowThreshold = threshold
+
+	if !h.opts.Explain || e.connect == nil {
+		return
+	}
+	if !h.opts.ExplainNonSelect && firstKeyword(e.Query) != "SELECT" {
+		return
+	}
+	if !h.allowExplain(e.Query) {
+		return
+	}
+	// EXPLAIN は新しいコネクションを開くため、Rows の Close などを待たせないようバックグラウンドで実行する
+	select {
+	case h.sem <- struct{}{}:
+	default:
+		h.forgetExplain(e.Query)
+		return
+	}
+	planned := *e
+	planned.Args = slices.Clone(e.Args)
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-h.sem }()
+		planned.Plan, planned.PlanErr = h.explain(ctx, &planned)
+		h.hooks.planned(ctx, &planned)
+	}()
+}
+
+// allowExplain は同じクエリの EXPLAIN を ExplainInterval に 1 回だけ許可する
+func (h *slowQueryHook) allowExplain(query string) bool {
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if last, ok := h.explained[query]; ok && now.Sub(last) < h.opts.ExplainInterval {
+		return false
+	}
+	// 古い記録が溜まり続けないよう、ある程度増えたら期限切れのものを捨てる
+	if len(h.explained) >= 1024 {
+		for q, last := range h.explained {
+			if now.Sub(last) >= h.opts.ExplainInterval {
+				delete(h.explained, q)
+			}
+		}
+	}
+	h.explained[query] = now
+	return true
+}
+
+// forgetExplain は実行できなかった EXPLAIN を次の遅いクエリで再度試せるようにする
+func (h *slowQueryHook) forgetExplain(query string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.explained, query)
+}
+
+// explain は内部ドライバーの新しいコネクションで EXPLAIN を実行し、結果の JSON を返す。
+// フックを通らないため、EXPLAIN 自体はログやメトリクスに現れない
+func (h *slowQueryHook) explain(ctx context.Context, e *Event) (string, error) {
+	var query string
+	switch h.system {
+	case "mysql":
+		query = "EXPLAIN FORMAT=JSON " + e.Query
+	case "postgresql":
+		query = "EXPLAIN (FORMAT JSON) " + e.Query
+	default:
+		return "", errors.New("customdriver: explain is not supported for this driver")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.opts.ExplainTimeout)
+	defer cancel()
+	conn, err := e.connect(ctx)
+	if err != nil {
+		return "", fmt.Errorf("customdriver: explain connect: %w", err)
+	}
+	defer conn.Close()
+
+	// 1 列目を行の順に連結する
+	var plan strings.Builder
+	err = rawQuery(ctx, conn, query, e.Args, func(rows driver.Rows) error {
+		dest := make([]driver.Value, len(rows.Columns()))
+		for {
+			if err := rows.Next(dest); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			switch v := dest[0].(type) {
+			case []byte:
+				plan.Write(v)
+			case string:
+				plan.WriteString(v)
+			default:
+				fmt.Fprint(&plan, v)
+			}
+		}
+	})
+	if err != nil {
+		return "", fmt.Errorf("customdriver: explain: %w", err)
+	}
+	return plan.String(), nil
+}
+
+// rawQuery はラップしていないコネクションでクエリを実行し、結果を read に渡す。
+// QueryerContext が使えない場合は database/sql と同様にプリペアドステートメントで実行する
+func rawQuery(ctx context.Context, conn driver.Conn, query string, args []driver.NamedValue, read func(driver.Rows) error) error {
+	if queryer, ok := conn.(driver.QueryerContext); ok {
+		rows, err := queryer.QueryContext(ctx, query, args)
+		if !errors.Is(err, driver.ErrSkip) {
+			if err != nil {
+				return err
+			}
+			return readRows(rows, read)
+		}
+	}
+
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = conn.Prepare(query)
+	}
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	var rows driver.Rows
+	if queryer, ok := stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		rows, err = queryLegacy(ctx, args, stmt.Query)
+	}
+	if err != nil {
+		return err
+	}
+	return readRows(rows, read)
+}
+
+func readRows(rows driver.Rows, read func(driver.Rows) error) error {
+	err := read(rows)
+	return errors.Join(err, rows.Close())
+}
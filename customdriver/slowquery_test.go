@@ -0,0 +1,255 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// filterRecords は records から msg のレコードだけを返す
+func filterRecords(records []map[string]any, msg string) []map[string]any {
+	var filtered []map[string]any
+	for _, r := range records {
+		if r["msg"] == msg {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// planWaiter はバックグラウンドの EXPLAIN の完了を通知する
+type planWaiter struct {
+	plans chan Event
+}
+
+func newPlanWaiter() *planWaiter {
+	return &planWaiter{plans: make(chan Event, 16)}
+}
+
+func (w *planWaiter) Before(ctx context.Context, e *Event) context.Context { return ctx }
+
+func (w *planWaiter) After(ctx context.Context, e *Event) {}
+
+func (w *planWaiter) Planned(ctx context.Context, e *Event) {
+	w.plans <- *e
+}
+
+// wait は n 件の実行計画が渡されるのを待つ。
+// planWaiter は LogHook より後に登録するため、Planned の呼び出しは LogHook の出力の後になる
+func (w *planWaiter) wait(t *testing.T, n int) {
+	t.Helper()
+
+	for range n {
+		select {
+		case <-w.plans:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for explain")
+		}
+	}
+}
+
+// explainConnector は 2 回目以降の接続 (EXPLAIN 用) を unblock が閉じられるまで待たせる。
+// Driver は MySQL のものを返すため、EXPLAIN FORMAT=JSON が内部のコネクションに渡される
+type explainConnector struct {
+	conn    driver.Conn
+	unblock chan struct{}
+	calls   atomic.Int32
+}
+
+func (c *explainConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	if c.calls.Add(1) == 1 {
+		return c.conn, nil
+	}
+	select {
+	case <-c.unblock:
+		return &legacyConn{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *explainConnector) Driver() driver.Driver {
+	return &mysql.MySQLDriver{}
+}
+
+// =============================================================================
+// Slow Query Tests
+// =============================================================================
+
+func TestSlowQuery_Thresholds(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	plans := newPlanWaiter()
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, logger, WithHooks(plans), WithSlowQuery(SlowQueryOptions{
+		Threshold:  time.Hour,
+		Thresholds: map[string]time.Duration{"ListUsers": time.Nanosecond},
+		Explain:    true,
+	})))
+	defer db.Close()
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(WithQueryName(ctx, "ListUsers"), "UPDATE users SET name = ?", "alice"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	for _, name := range []string{"ListUsers", "GetUser"} {
+		rows, err := db.QueryContext(WithQueryName(ctx, name), "SELECT id, name FROM users")
+		if err != nil {
+			t.Fatalf("QueryContext failed: %v", err)
+		}
+		for rows.Next() {
+		}
+		rows.Close()
+	}
+	// UPDATE は ExplainNonSelect がないため EXPLAIN しない。
+	// SELECT は内部ドライバーが MySQL / PostgreSQL でないため EXPLAIN に失敗する
+	plans.wait(t, 1)
+
+	all := decodeLogs(t, &buf)
+	records, planRecords := filterRecords(all, "slow query"), filterRecords(all, "slow query plan")
+	if len(records) != 2 {
+		t.Fatalf("expected 2 slow query records, got %d: %v", len(records), records)
+	}
+	for _, r := range records {
+		if r["level"] != "WARN" || r["query_name"] != "ListUsers" || r["threshold"] != float64(time.Nanosecond) {
+			t.Errorf("unexpected slow query record %v", r)
+		}
+	}
+	if len(planRecords) != 1 {
+		t.Fatalf("expected 1 slow query plan record, got %v", planRecords)
+	}
+	if r := planRecords[0]; r["query"] != "SELECT id, name FROM users" || r["plan_error"] == nil {
+		t.Errorf("expected explain error for unsupported driver, got %v", r)
+	}
+}
+
+func TestSlowQuery_ExplainInBackground(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	plans := newPlanWaiter()
+	connector := &explainConnector{conn: &legacyConn{}, unblock: make(chan struct{})}
+	db := sql.OpenDB(NewCustomConnector(connector, logger, WithHooks(plans), WithSlowQuery(SlowQueryOptions{
+		Threshold: time.Nanosecond,
+		Explain:   true,
+	})))
+	defer db.Close()
+
+	// EXPLAIN の接続が終わらなくても Rows の Close は待たされない
+	rows, err := db.Query("SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	for rows.Next() {
+	}
+	done := make(chan error, 1)
+	go func() { done <- rows.Close() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("rows.Close blocked on explain")
+	}
+	if records := filterRecords(decodeLogs(t, &buf), "slow query"); len(records) != 1 {
+		t.Fatalf("expected 1 slow query record, got %v", records)
+	}
+
+	close(connector.unblock)
+	plans.wait(t, 1)
+	records := filterRecords(decodeLogs(t, &buf), "slow query plan")
+	if len(records) != 1 {
+		t.Fatalf("expected 1 slow query plan record, got %v", records)
+	}
+	if r := records[0]; r["level"] != "WARN" || r["query"] != "SELECT id, name FROM users" || r["plan"] == nil || r["plan_error"] != nil {
+		t.Errorf("unexpected slow query plan record %v", r)
+	}
+}
+
+func TestMySQL_SlowQueryExplain(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	plans := newPlanWaiter()
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithHooks(plans), WithSlowQuery(SlowQueryOptions{
+		Threshold: time.Nanosecond,
+		Explain:   true,
+	})))
+	defer db.Close()
+	ctx := context.Background()
+
+	for range 2 {
+		var count int
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users WHERE name = ?", "slow-user").Scan(&count); err != nil {
+			t.Fatalf("SELECT failed: %v", err)
+		}
+	}
+	plans.wait(t, 1)
+
+	all := decodeLogs(t, &buf)
+	records, planRecords := filterRecords(all, "slow query"), filterRecords(all, "slow query plan")
+	if len(records) != 2 {
+		t.Fatalf("expected 2 slow query records, got %d", len(records))
+	}
+	// 同じクエリの EXPLAIN は ExplainInterval の間は実行しない
+	if len(planRecords) != 1 {
+		t.Fatalf("expected explain to be rate limited, got %v", planRecords)
+	}
+	plan, ok := planRecords[0]["plan"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected JSON plan, got %v", planRecords[0])
+	}
+	if _, ok := plan["query_block"]; !ok {
+		t.Errorf("expected query_block in MySQL plan, got %v", plan)
+	}
+}
+
+func TestPostgreSQL_SlowQueryExplain(t *testing.T) {
+	truncatePgUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	plans := newPlanWaiter()
+	db := sql.OpenDB(NewCustomConnector(pgConnector, logger, WithHooks(plans), WithSlowQuery(SlowQueryOptions{
+		Threshold: time.Nanosecond,
+		Explain:   true,
+	})))
+	defer db.Close()
+	ctx := context.Background()
+
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users WHERE name = $1", "slow-user")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE name = $1", "slow-user"); err != nil {
+		t.Fatalf("DELETE failed: %v", err)
+	}
+	plans.wait(t, 1)
+
+	all := decodeLogs(t, &buf)
+	if records := filterRecords(all, "slow query"); len(records) != 2 {
+		t.Fatalf("expected 2 slow query records, got %d", len(records))
+	}
+	// DELETE は ExplainNonSelect がないため EXPLAIN しない
+	records := filterRecords(all, "slow query plan")
+	if len(records) != 1 {
+		t.Fatalf("expected 1 slow query plan record, got %v", records)
+	}
+	plan, ok := records[0]["plan"].([]any)
+	if !ok || len(plan) == 0 {
+		t.Fatalf("expected JSON plan, got %v", records[0])
+	}
+	if _, ok := plan[0].(map[string]any)["Plan"]; !ok {
+		t.Errorf("expected Plan in PostgreSQL plan, got %v", plan[0])
+	}
+}
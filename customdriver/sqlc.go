@@ -0,0 +1,37 @@
+package customdriver
+
+import (
+	"strings"
+)
+
+const sqlcHeaderPrefix = "-- name: "
+
+// WithOneRowCheck は sqlc の :one のクエリが 2 行以上を返した場合に Event.MultipleRows を設定し、
+// LogHook で警告を出力する。
+// QueryRow は 1 行目だけを読んで Close するため、Close の前に 2 行目を 1 回だけ読み出して確認する
+func WithOneRowCheck() Option {
+	return func(cfg *config) {
+		cfg.oneRowCheck = true
+	}
+}
+
+// parseSQLCHeader は sqlc が生成したクエリの先頭の -- name: GetUserByName :one から
+// クエリ名とコマンド (one) を取り出す。ヘッダーがない場合は空文字列を返す
+func parseSQLCHeader(query string) (name, command string) {
+	rest, ok := strings.CutPrefix(query, sqlcHeaderPrefix)
+	if !ok {
+		return "", ""
+	}
+	line, _, _ := strings.Cut(rest, "\n")
+	fields := strings.Fields(line)
+	if len(fields) < 2 || !strings.HasPrefix(fields[1], ":") {
+		return "", ""
+	}
+	return fields[0], fields[1][1:]
+}
+
+// setQuery は e にクエリと sqlc のヘッダーから取り出した名前・コマンドを設定する
+func (e *Event) setQuery(query string) {
+	e.Query = query
+	e.QueryName, e.Command = parseSQLCHeader(query)
+}
@@ -0,0 +1,156 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+
+	"github.com/replu/goconmini-sendai-2026/sqlc/mysqlquery"
+)
+
+// =============================================================================
+// sqlc Header Tests
+// =============================================================================
+
+func TestParseSQLCHeader(t *testing.T) {
+	tests := []struct {
+		query       string
+		wantName    string
+		wantCommand string
+	}{
+		{"-- name: GetUserByName :one\nSELECT id FROM users WHERE name = ?", "GetUserByName", "one"},
+		{"-- name: ListUsers :many\r\nSELECT id FROM users", "ListUsers", "many"},
+		{"-- name: DeleteUser :execrows", "DeleteUser", "execrows"},
+		{"-- name: Broken\nSELECT 1", "", ""},
+		{"-- comment\nSELECT 1", "", ""},
+		{"SELECT 1 -- name: GetUser :one", "", ""},
+	}
+	for _, tt := range tests {
+		name, command := parseSQLCHeader(tt.query)
+		if name != tt.wantName || command != tt.wantCommand {
+			t.Errorf("%q: expected (%q, %q), got (%q, %q)", tt.query, tt.wantName, tt.wantCommand, name, command)
+		}
+	}
+}
+
+func TestSQLC_QueryNameAndCommand(t *testing.T) {
+	var buf bytes.Buffer
+	hook := &recordingHook{}
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, logger, WithHooks(hook)))
+	defer db.Close()
+	// sqlc のヘッダーは WithQueryName より優先される
+	ctx := WithQueryName(context.Background(), "Other")
+
+	if _, err := db.ExecContext(ctx, "-- name: DeleteUsers :execrows\nDELETE FROM users"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	stmt, err := db.PrepareContext(ctx, "-- name: ListUsers :many\nSELECT id, name FROM users WHERE id > ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, 0)
+	if err != nil {
+		t.Fatalf("stmt Query failed: %v", err)
+	}
+	rows.Close()
+	if _, err := db.ExecContext(ctx, "DELETE FROM posts"); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+
+	want := map[string][2]string{
+		"sql executed":     {"DeleteUsers", "execrows"},
+		"stmt queried":     {"ListUsers", "many"},
+		"stmt rows closed": {"ListUsers", "many"},
+	}
+	var seen int
+	for _, r := range decodeLogs(t, &buf) {
+		w, ok := want[r["msg"].(string)]
+		if !ok || r["query"] == "DELETE FROM posts" {
+			continue
+		}
+		seen++
+		if r["query_name"] != w[0] || r["query_command"] != w[1] {
+			t.Errorf("%s: expected %v, got %v %v", r["msg"], w, r["query_name"], r["query_command"])
+		}
+	}
+	if seen != len(want) {
+		t.Errorf("expected %d records, got %d", len(want), seen)
+	}
+
+	last := hook.events[len(hook.events)-1]
+	if last.QueryName != "Other" || last.Command != "" {
+		t.Errorf("expected name from context for non-sqlc query, got %q %q", last.QueryName, last.Command)
+	}
+}
+
+func TestSQLC_OneRowCheck(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, logger, WithOneRowCheck()))
+	defer db.Close()
+	ctx := context.Background()
+
+	// constdriver は常に 2 行を返す
+	var id int64
+	var name string
+	if err := db.QueryRowContext(ctx, "-- name: GetUser :one\nSELECT id, name FROM users LIMIT 1").Scan(&id, &name); err != nil {
+		t.Fatalf("QueryRowContext failed: %v", err)
+	}
+	rows, err := db.QueryContext(ctx, "-- name: ListUsers :many\nSELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	rows.Close()
+
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "multiple rows returned for :one query"); n != 1 {
+		t.Fatalf("expected 1 warning, got %d", n)
+	}
+	for _, r := range records {
+		if r["msg"] == "multiple rows returned for :one query" && r["query_name"] != "GetUser" {
+			t.Errorf("unexpected warning: %v", r)
+		}
+	}
+}
+
+func TestMySQL_SQLCQueryName(t *testing.T) {
+	truncateMySQLUsers(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	metrics := NewMetricsHook(&MetricsOptions{QueryNameLabel: true})
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithHooks(metrics), WithOneRowCheck()))
+	defer db.Close()
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "alice"); err != nil {
+			t.Fatalf("INSERT failed: %v", err)
+		}
+	}
+	if _, err := mysqlquery.New(db).GetUserByName(ctx, "alice"); err != nil {
+		t.Fatalf("GetUserByName failed: %v", err)
+	}
+
+	// LIMIT 1 があるため警告されない
+	records := decodeLogs(t, &buf)
+	if n := countMessages(records, "multiple rows returned for :one query"); n != 0 {
+		t.Errorf("expected no warning, got %d", n)
+	}
+	var named int
+	for _, r := range records {
+		if r["query_name"] == "GetUserByName" && r["query_command"] == "one" {
+			named++
+		}
+	}
+	if named == 0 {
+		t.Errorf("expected records named GetUserByName")
+	}
+	if _, ok := scrape(t, metrics)[`customdriver_operations_total{op="query",query_name="GetUserByName"}`]; !ok {
+		t.Errorf("expected operations_total labeled with GetUserByName")
+	}
+}
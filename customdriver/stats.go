@@ -0,0 +1,181 @@
+package customdriver
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	_ Hook = (*StatsHook)(nil)
+)
+
+const (
+	defaultStatsReservoirSize   = 1024
+	defaultStatsMaxFingerprints = 5000
+)
+
+// StatsOptions は StatsHook の集計方法を調整する
+type StatsOptions struct {
+	// パーセンタイルの計算のためにフィンガープリントごとに保持する実行時間の数。0 の場合は 1024。
+	// 超えた場合は無作為に選んだ値だけを残す
+	ReservoirSize int
+	// 集計するフィンガープリントの上限。0 の場合は 5000。超えた後に現れたフィンガープリントは集計しない
+	MaxFingerprints int
+}
+
+// QueryStats は 1 つのフィンガープリントの集計結果
+type QueryStats struct {
+	Fingerprint string `json:"fingerprint"`
+	// 最初に実行されたクエリ
+	Query string `json:"query"`
+
+	Calls  int64 `json:"calls"`
+	Errors int64 `json:"errors"`
+	// Exec の影響を受けた行数と Query で読み出した行数の合計
+	Rows int64 `json:"rows"`
+
+	TotalTime time.Duration `json:"total_time"`
+	MeanTime  time.Duration `json:"mean_time"`
+	MinTime   time.Duration `json:"min_time"`
+	MaxTime   time.Duration `json:"max_time"`
+	P50       time.Duration `json:"p50"`
+	P95       time.Duration `json:"p95"`
+	P99       time.Duration `json:"p99"`
+}
+
+// StatsHook は Exec / Query をフィンガープリントごとに集計するフック。
+// pg_stat_statements のように、サーバー側の拡張なしで時間のかかっているクエリを調べるために使う。
+// Query の時間は Rows の Close までを含む
+type StatsHook struct {
+	opts StatsOptions
+
+	mu    sync.Mutex
+	stats map[string]*queryStats
+
+	fingerprints *fingerprintCache
+}
+
+type queryStats struct {
+	QueryStats
+	// 実行時間の無作為標本
+	samples []time.Duration
+}
+
+// opts が nil の場合は既定値を使う
+func NewStatsHook(opts *StatsOptions) *StatsHook {
+	h := &StatsHook{
+		stats: map[string]*queryStats{},
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.ReservoirSize <= 0 {
+		h.opts.ReservoirSize = defaultStatsReservoirSize
+	}
+	if h.opts.MaxFingerprints <= 0 {
+		h.opts.MaxFingerprints = defaultStatsMaxFingerprints
+	}
+	h.fingerprints = newFingerprintCache(h.opts.MaxFingerprints * 4)
+	return h
+}
+
+func (h *StatsHook) Before(ctx context.Context, e *Event) context.Context {
+	return ctx
+}
+
+func (h *StatsHook) After(ctx context.Context, e *Event) {
+	var rows int64
+	switch e.Op {
+	case OpExec:
+		rows = e.RowsAffected
+	case OpQuery:
+		// 成功したクエリは Rows の Close で集計する
+		if e.Err == nil {
+			return
+		}
+	case OpRowsClose:
+		rows = e.RowsRead
+	default:
+		return
+	}
+
+	fp := h.fingerprints.get(e)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.stats[fp]
+	if !ok {
+		if len(h.stats) >= h.opts.MaxFingerprints {
+			return
+		}
+		s = &queryStats{QueryStats: QueryStats{Fingerprint: fp, Query: e.Query, MinTime: e.Duration}}
+		h.stats[fp] = s
+	}
+	s.Calls++
+	if e.Err != nil {
+		s.Errors++
+	}
+	s.Rows += rows
+	s.TotalTime += e.Duration
+	s.MinTime = min(s.MinTime, e.Duration)
+	s.MaxTime = max(s.MaxTime, e.Duration)
+	// Algorithm R で ReservoirSize 個の標本を保つ
+	if len(s.samples) < h.opts.ReservoirSize {
+		s.samples = append(s.samples, e.Duration)
+	} else if i := rand.Int64N(s.Calls); i < int64(h.opts.ReservoirSize) {
+		s.samples[i] = e.Duration
+	}
+}
+
+// Stats は集計結果を合計時間の長い順に返す
+func (h *StatsHook) Stats() []QueryStats {
+	h.mu.Lock()
+	result := make([]QueryStats, 0, len(h.stats))
+	samples := make([][]time.Duration, 0, len(h.stats))
+	for _, s := range h.stats {
+		result = append(result, s.QueryStats)
+		samples = append(samples, slices.Clone(s.samples))
+	}
+	h.mu.Unlock()
+
+	for i := range result {
+		r := &result[i]
+		r.MeanTime = r.TotalTime / time.Duration(r.Calls)
+		slices.Sort(samples[i])
+		r.P50 = percentile(samples[i], 0.50)
+		r.P95 = percentile(samples[i], 0.95)
+		r.P99 = percentile(samples[i], 0.99)
+	}
+	slices.SortFunc(result, func(a, b QueryStats) int {
+		return cmp.Or(cmp.Compare(b.TotalTime, a.TotalTime), cmp.Compare(a.Fingerprint, b.Fingerprint))
+	})
+	return result
+}
+
+// Reset は集計結果を破棄する
+func (h *StatsHook) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	clear(h.stats)
+}
+
+// WriteJSON は Stats の結果を JSON で w に書き出す。時間はナノ秒で出力する
+func (h *StatsHook) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(h.Stats())
+}
+
+// percentile はソート済みの sorted から p (0 から 1) のパーセンタイルを最近傍法で返す
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(i, len(sorted)-1))]
+}
@@ -0,0 +1,129 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+// =============================================================================
+// Stats Tests
+// =============================================================================
+
+func TestStatsHook(t *testing.T) {
+	hook := NewStatsHook(&StatsOptions{ReservoirSize: 2})
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &legacyConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+	ctx := context.Background()
+
+	for _, q := range []string{
+		"UPDATE users SET name = 'alice' WHERE id = 1",
+		"UPDATE users SET name = 'bob' WHERE id = 2",
+		"update users set name = 'carol'   where id = 3",
+	} {
+		if _, err := db.ExecContext(ctx, q); err != nil {
+			t.Fatalf("ExecContext failed: %v", err)
+		}
+	}
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users WHERE id IN (?, ?)", 1, 2)
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+
+	stats := hook.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 fingerprints, got %d: %+v", len(stats), stats)
+	}
+	byFingerprint := map[string]QueryStats{}
+	for _, s := range stats {
+		byFingerprint[s.Fingerprint] = s
+	}
+
+	update, ok := byFingerprint["update users set name = ? where id = ?"]
+	if !ok {
+		t.Fatalf("expected update fingerprint, got %+v", stats)
+	}
+	if update.Calls != 3 || update.Rows != 3 || update.Errors != 0 {
+		t.Errorf("unexpected update stats %+v", update)
+	}
+	if update.Query != "UPDATE users SET name = 'alice' WHERE id = 1" {
+		t.Errorf("expected first query as example, got %q", update.Query)
+	}
+	if update.MinTime > update.P50 || update.P50 > update.P99 || update.P99 > update.MaxTime || update.MeanTime <= 0 {
+		t.Errorf("inconsistent timings %+v", update)
+	}
+
+	query, ok := byFingerprint["select id, name from users where id in (...)"]
+	if !ok || query.Calls != 1 || query.Rows != 2 {
+		t.Errorf("unexpected query stats %+v", query)
+	}
+
+	var buf bytes.Buffer
+	if err := hook.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode JSON: %v", err)
+	}
+	if len(decoded) != 2 || decoded[0]["fingerprint"] == nil || decoded[0]["p95"] == nil {
+		t.Errorf("unexpected JSON %s", buf.String())
+	}
+
+	hook.Reset()
+	if got := hook.Stats(); len(got) != 0 {
+		t.Errorf("expected no stats after reset, got %+v", got)
+	}
+}
+
+func TestStatsHook_Errors(t *testing.T) {
+	hook := NewStatsHook(nil)
+	db := sql.OpenDB(NewCustomConnector(&staticConnector{conn: &failingConn{}}, nil, WithHooks(hook)))
+	defer db.Close()
+
+	for _, name := range []string{"alice", "bob"} {
+		if _, err := db.ExecContext(context.Background(), "UPDATE users SET name = ?", name); err == nil {
+			t.Fatalf("expected exec error")
+		}
+	}
+
+	stats := hook.Stats()
+	if len(stats) != 1 || stats[0].Calls != 2 || stats[0].Errors != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestPostgreSQL_Stats(t *testing.T) {
+	truncatePgUsers(t)
+	ctx := context.Background()
+
+	hook := NewStatsHook(nil)
+	db := sql.OpenDB(NewCustomConnector(pgConnector, nil, WithHooks(hook)))
+	defer db.Close()
+
+	for _, name := range []string{"stats-1", "stats-2", "stats-3"} {
+		if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES ($1)", name); err != nil {
+			t.Fatalf("INSERT failed: %v", err)
+		}
+	}
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users WHERE name LIKE 'stats-%'").Scan(&count); err != nil {
+		t.Fatalf("SELECT failed: %v", err)
+	}
+
+	byFingerprint := map[string]QueryStats{}
+	for _, s := range hook.Stats() {
+		byFingerprint[s.Fingerprint] = s
+	}
+	if s := byFingerprint["insert into users (name) values (?)"]; s.Calls != 3 || s.Rows != 3 {
+		t.Errorf("unexpected insert stats %+v", s)
+	}
+	if s := byFingerprint["select count (*) from users where name like ?"]; s.Calls != 1 || s.Rows != 1 {
+		t.Errorf("unexpected select stats %+v", s)
+	}
+}
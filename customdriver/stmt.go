@@ -3,24 +3,59 @@ package customdriver
 import (
 	"context"
 	"database/sql/driver"
-	"log/slog"
-	"time"
 )
 
 var (
 	_ driver.Stmt              = (*customStmt)(nil)
 	_ driver.StmtExecContext   = (*customStmt)(nil)
 	_ driver.StmtQueryContext  = (*customStmt)(nil)
+	_ driver.ColumnConverter   = (*customStmt)(nil)
 	_ driver.NamedValueChecker = (*customStmt)(nil)
 )
 
+// customStmt も customConn と同様に wrapStmt で内部の Stmt と同じインターフェースだけを公開する
 type customStmt struct {
-	stmt   driver.Stmt
-	logger *slog.Logger
-	query  string
+	stmt  driver.Stmt
+	conn  *customConn
+	cfg   *config
+	id    uint64
+	query string
+	// sqlc のヘッダーから Prepare 時に 1 回だけ取り出した名前とコマンド
+	queryName string
+	command   string
+	// LeakDetector に記録した ID
+	leakID uint64
+	// stmt を準備した時点の customConn.generation
+	generation uint64
+}
+
+func newCustomStmt(stmt driver.Stmt, conn *customConn, id uint64, query string) driver.Stmt {
+	queryName, command := parseSQLCHeader(query)
+	return wrapStmt(&customStmt{
+		stmt:       stmt,
+		conn:       conn,
+		cfg:        conn.cfg,
+		id:         id,
+		query:      query,
+		queryName:  queryName,
+		command:    command,
+		leakID:     conn.cfg.leaks.track(ObjectStmt, conn.id, query),
+		generation: conn.generation,
+	})
+}
+
+func (s *customStmt) newEvent(op Op) *Event {
+	e := s.conn.newEvent(op)
+	e.StmtID = s.id
+	e.Query = s.query
+	e.QueryName = s.queryName
+	e.Command = s.command
+	e.Stmt = true
+	return e
 }
 
 func (s *customStmt) Close() error {
+	s.cfg.leaks.untrack(s.leakID)
 	return s.stmt.Close()
 }
 
@@ -28,121 +63,120 @@ func (s *customStmt) NumInput() int {
 	return s.stmt.NumInput()
 }
 
+// Exec は customConn.Exec と同じく ExecContext と同じ処理を通し、リトライとサーキットブレーカーを適用する
 func (s *customStmt) Exec(args []driver.Value) (driver.Result, error) {
-	start := time.Now()
-	result, err := s.stmt.Exec(args)
-	duration := time.Since(start)
-
-	if err != nil {
-		s.logger.Error("stmt execution failed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		s.logger.Info("stmt executed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
-	}
-
-	return result, err
+	return s.ExecContext(s.conn.baseContext(), valuesToNamedValues(args))
 }
 
 func (s *customStmt) Query(args []driver.Value) (driver.Rows, error) {
-	start := time.Now()
-	rows, err := s.stmt.Query(args)
-	duration := time.Since(start)
+	return s.QueryContext(s.conn.baseContext(), valuesToNamedValues(args))
+}
 
+// prepareAgain はリトライ時の再接続でコネクションが置き換えられていた場合に、新しいコネクションで stmt を準備し直す
+func (s *customStmt) prepareAgain(ctx context.Context) error {
+	if s.generation == s.conn.generation {
+		return nil
+	}
+	e := s.conn.newEvent(OpPrepare)
+	e.setQuery(s.query)
+	e.StmtID = s.id
+	ctx = s.cfg.hooks.before(ctx, e)
+	var stmt driver.Stmt
+	generation, err := s.cfg.breaker.allow()
+	if err == nil {
+		if prepareCtx, ok := s.conn.conn.(driver.ConnPrepareContext); ok {
+			stmt, err = prepareCtx.PrepareContext(ctx, s.cfg.commentQuery(ctx, s.query, true))
+		} else {
+			stmt, err = s.conn.conn.Prepare(s.query)
+		}
+		s.cfg.breaker.done(generation, err)
+	}
+	s.cfg.hooks.after(ctx, e, err)
 	if err != nil {
-		s.logger.Error("stmt query failed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		s.logger.Info("stmt queried",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
+		return err
 	}
 
-	return rows, err
+	// 閉じたコネクションの stmt を閉じるエラーは無視する
+	_ = s.stmt.Close()
+	s.stmt = stmt
+	s.generation = s.conn.generation
+	return nil
 }
 
 func (s *customStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
-	stmtExecCtx, ok := s.stmt.(driver.StmtExecContext)
-	if !ok {
-		// fallback
-		dargs := make([]driver.Value, len(args))
-		for i, nv := range args {
-			dargs[i] = nv.Value
+	r := s.conn.newRetrier(ctx, OpExec, s.query)
+	for {
+		if err := s.prepareAgain(ctx); err != nil {
+			return nil, err
+		}
+		result, err := s.execContext(ctx, args, r)
+		if !s.conn.retry(ctx, r, err) {
+			return result, err
 		}
-		return s.Exec(dargs)
 	}
+}
 
-	start := time.Now()
-	result, err := stmtExecCtx.ExecContext(ctx, args)
-	duration := time.Since(start)
-
-	if err != nil {
-		s.logger.Error("stmt execution failed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		s.logger.Info("stmt executed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
+func (s *customStmt) execContext(ctx context.Context, args []driver.NamedValue, r *retrier) (driver.Result, error) {
+	e := s.newEvent(OpExec)
+	e.Args = args
+	ctx = s.cfg.hooks.before(ctx, e)
+	var result driver.Result
+	generation, err := s.cfg.breaker.allow()
+	if err == nil {
+		if stmtExecCtx, ok := s.stmt.(driver.StmtExecContext); ok {
+			result, err = stmtExecCtx.ExecContext(ctx, args)
+		} else {
+			result, err = execLegacy(ctx, args, s.stmt.Exec)
+		}
+		s.cfg.breaker.done(generation, err)
 	}
+	e.setResult(result)
+	r.check(ctx, e, err)
+	s.conn.finishStatement(ctx, e, err)
 
 	return result, err
 }
 
 func (s *customStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
-	stmtQueryCtx, ok := s.stmt.(driver.StmtQueryContext)
-	if ok {
-		// fallback
-		dargs := make([]driver.Value, len(args))
-		for i, nv := range args {
-			dargs[i] = nv.Value
+	r := s.conn.newRetrier(ctx, OpQuery, s.query)
+	for {
+		if err := s.prepareAgain(ctx); err != nil {
+			return nil, err
+		}
+		rows, err := s.queryContext(ctx, args, r)
+		if !s.conn.retry(ctx, r, err) {
+			return rows, err
 		}
-		return s.Query(dargs)
 	}
-	start := time.Now()
-	rows, err := stmtQueryCtx.QueryContext(ctx, args)
-	duration := time.Since(start)
+}
 
+func (s *customStmt) queryContext(ctx context.Context, args []driver.NamedValue, r *retrier) (driver.Rows, error) {
+	e := s.newEvent(OpQuery)
+	e.Args = args
+	ctx = s.cfg.hooks.before(ctx, e)
+	var rows driver.Rows
+	generation, err := s.cfg.breaker.allow()
+	if err == nil {
+		if stmtQueryCtx, ok := s.stmt.(driver.StmtQueryContext); ok {
+			rows, err = stmtQueryCtx.QueryContext(ctx, args)
+		} else {
+			rows, err = queryLegacy(ctx, args, s.stmt.Query)
+		}
+		s.cfg.breaker.done(generation, err)
+	}
+	r.check(ctx, e, err)
+	s.conn.finishStatement(ctx, e, err)
 	if err != nil {
-		s.logger.Error("stmt query context failed",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		s.logger.Info("stmt queried context ",
-			slog.String("query", s.query),
-			slog.Any("args", args),
-			slog.Duration("duration", duration),
-		)
+		return nil, err
 	}
 
-	return rows, err
+	return newCustomRows(ctx, s.conn, e, rows), nil
 }
 
-func (c *customStmt) CheckNamedValue(nv *driver.NamedValue) error {
-	if checker, ok := c.stmt.(driver.NamedValueChecker); ok {
-		return checker.CheckNamedValue(nv)
-	}
+func (s *customStmt) ColumnConverter(idx int) driver.ValueConverter {
+	return s.stmt.(driver.ColumnConverter).ColumnConverter(idx)
+}
 
-	return driver.ErrSkip
+func (s *customStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	return s.stmt.(driver.NamedValueChecker).CheckNamedValue(nv)
 }
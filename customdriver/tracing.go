@@ -0,0 +1,147 @@
+package customdriver
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ Hook = (*TracingHook)(nil)
+)
+
+const tracerName = "github.com/replu/goconmini-sendai-2026/customdriver"
+
+// OpenTelemetry のデータベース向けセマンティック規約の属性
+const (
+	attrDBSystem       = attribute.Key("db.system")
+	attrDBStatement    = attribute.Key("db.statement")
+	attrDBOperation    = attribute.Key("db.operation")
+	attrDBRowsAffected = attribute.Key("db.rows_affected")
+	attrDBRowsReturned = attribute.Key("db.rows_returned")
+	attrDBQueryName    = attribute.Key("db.query.name")
+	attrErrorType      = attribute.Key("error.type")
+)
+
+// TracingHook はドライバー操作ごとに OpenTelemetry のスパンを作成するフック。
+// スパンは呼び出し元の context のスパンを親とする。
+// Query のスパンは Rows が閉じられるまで続き、読み出した行数が記録される。
+// 内部ドライバーが driver.ErrSkip を返した操作のスパンは終了しないため、エクスポートされない
+type TracingHook struct {
+	tracer trace.Tracer
+}
+
+// tp が nil の場合はグローバルな TracerProvider を使う
+func NewTracingHook(tp trace.TracerProvider) *TracingHook {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &TracingHook{
+		tracer: tp.Tracer(tracerName),
+	}
+}
+
+func (h *TracingHook) Before(ctx context.Context, e *Event) context.Context {
+	if !traced(e.Op) {
+		return ctx
+	}
+
+	attrs := make([]attribute.KeyValue, 0, 4)
+	if e.DBSystem != "" {
+		attrs = append(attrs, attrDBSystem.String(e.DBSystem))
+	}
+	if e.Query != "" {
+		attrs = append(attrs, attrDBStatement.String(e.Query))
+	}
+	if op := dbOperation(e); op != "" {
+		attrs = append(attrs, attrDBOperation.String(op))
+	}
+	if e.QueryName != "" {
+		attrs = append(attrs, attrDBQueryName.String(e.QueryName))
+	}
+	ctx, _ = h.tracer.Start(ctx, spanName(e),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+func (h *TracingHook) After(ctx context.Context, e *Event) {
+	switch {
+	case e.Op == OpRowsClose:
+		// クエリの Before が返した context が渡されるため、クエリのスパンを終了する
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(attrDBRowsReturned.Int64(e.RowsRead))
+		endSpan(span, e)
+	case !traced(e.Op):
+	case e.Op == OpQuery && e.Err == nil:
+		// 成功したクエリのスパンは Rows の Close で終了する
+	default:
+		span := trace.SpanFromContext(ctx)
+		if e.Op == OpExec && e.Err == nil {
+			span.SetAttributes(attrDBRowsAffected.Int64(e.RowsAffected))
+		}
+		endSpan(span, e)
+	}
+}
+
+func traced(op Op) bool {
+	switch op {
+	case OpConnect, OpPrepare, OpExec, OpQuery, OpBegin, OpCommit, OpRollback:
+		return true
+	}
+	return false
+}
+
+func endSpan(span trace.Span, e *Event) {
+	if e.Err != nil {
+		span.RecordError(e.Err)
+		span.SetStatus(codes.Error, e.Err.Error())
+		span.SetAttributes(attrErrorType.String(string(e.Category)))
+	}
+	span.End()
+}
+
+// spanName は "sql.query" や "sql.stmt.exec" のようなスパン名を返す
+func spanName(e *Event) string {
+	if e.Stmt {
+		return "sql.stmt." + string(e.Op)
+	}
+	return "sql." + string(e.Op)
+}
+
+// dbOperation は db.operation の値を返す。クエリの場合は先頭のキーワードを使う
+func dbOperation(e *Event) string {
+	switch e.Op {
+	case OpBegin, OpCommit, OpRollback:
+		return strings.ToUpper(string(e.Op))
+	case OpPrepare, OpExec, OpQuery:
+		return firstKeyword(e.Query)
+	}
+	return ""
+}
+
+// firstKeyword は先頭のコメントと空白を読み飛ばし、最初の単語を大文字で返す
+func firstKeyword(query string) string {
+	for {
+		query = strings.TrimLeft(query, " \t\r\n(")
+		switch {
+		case strings.HasPrefix(query, "--"):
+			_, query, _ = strings.Cut(query, "\n")
+		case strings.HasPrefix(query, "/*"):
+			_, query, _ = strings.Cut(query, "*/")
+		default:
+			end := strings.IndexFunc(query, func(r rune) bool {
+				return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z')
+			})
+			if end < 0 {
+				end = len(query)
+			}
+			return strings.ToUpper(query[:end])
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package customdriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTracedDB は connector を TracingHook でラップした DB と、
+// スパンの出力先、親スパンを持つ context を返す
+func newTracedDB(t *testing.T, connector driver.Connector) (*sql.DB, *tracetest.InMemoryExporter, context.Context, trace.Span) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+
+	db := sql.OpenDB(NewCustomConnector(connector, nil, WithHooks(NewTracingHook(tp))))
+	t.Cleanup(func() { db.Close() })
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	return db, exporter, ctx, parent
+}
+
+// spanAttr はスパンの属性を返す。属性がない場合は無効な Value を返す
+func spanAttr(s tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// childSpans は parent を親に持つスパンを名前の順に返す
+func childSpans(exporter *tracetest.InMemoryExporter, parent trace.Span) []tracetest.SpanStub {
+	var children []tracetest.SpanStub
+	for _, s := range exporter.GetSpans() {
+		if s.Parent.SpanID() == parent.SpanContext().SpanID() {
+			children = append(children, s)
+		}
+	}
+	return children
+}
+
+func spanNames(spans []tracetest.SpanStub) []string {
+	names := make([]string, len(spans))
+	for i, s := range spans {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// =============================================================================
+// Tracing Tests
+// =============================================================================
+
+func TestTracingHook(t *testing.T) {
+	db, exporter, ctx, parent := newTracedDB(t, &staticConnector{conn: &failingConn{}})
+
+	if _, err := db.ExecContext(ctx, "UPDATE users SET name = ?", "alice"); err == nil {
+		t.Fatalf("expected exec error")
+	}
+	rows, err := db.QueryContext(WithQueryName(ctx, "ListUsers"), "/* list */ SELECT id, name FROM users")
+	if err != nil {
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	for _, s := range exporter.GetSpans() {
+		if s.Name == "sql.query" {
+			t.Fatalf("expected query span to stay open until rows close")
+		}
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	parent.End()
+
+	var exec, query tracetest.SpanStub
+	for _, s := range childSpans(exporter, parent) {
+		switch s.Name {
+		case "sql.exec":
+			exec = s
+		case "sql.query":
+			query = s
+		}
+	}
+	if exec.Name == "" || query.Name == "" {
+		t.Fatalf("expected exec and query spans under parent, got %v", spanNames(exporter.GetSpans()))
+	}
+	if exec.Status.Code != codes.Error || len(exec.Events) == 0 {
+		t.Errorf("expected exec span to record the error, got %v", exec.Status)
+	}
+	if got := spanAttr(exec, attrErrorType).AsString(); got != string(CategoryUnknown) {
+		t.Errorf("expected error.type unknown, got %q", got)
+	}
+	if got := spanAttr(exec, attrDBOperation).AsString(); got != "UPDATE" {
+		t.Errorf("expected db.operation UPDATE, got %q", got)
+	}
+	if got := spanAttr(query, attrDBOperation).AsString(); got != "SELECT" {
+		t.Errorf("expected db.operation SELECT, got %q", got)
+	}
+	if got := spanAttr(query, attrDBQueryName).AsString(); got != "ListUsers" {
+		t.Errorf("expected db.query.name ListUsers, got %q", got)
+	}
+	if got := spanAttr(query, attrDBRowsReturned).AsInt64(); got != 2 {
+		t.Errorf("expected 2 rows returned, got %d", got)
+	}
+	if query.Status.Code == codes.Error {
+		t.Errorf("unexpected query span status %v", query.Status)
+	}
+}
+
+func TestMySQL_TracingCRUD(t *testing.T) {
+	truncateMySQLUsers(t)
+	db, exporter, ctx, parent := newTracedDB(t, mysqlConnector)
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", "trace-user"); err != nil {
+		t.Fatalf("INSERT failed: %v", err)
+	}
+	var name string
+	if err := db.QueryRowContext(ctx, "SELECT name FROM users WHERE name = ?", "trace-user").Scan(&name); err != nil {
+		t.Fatalf("SELECT failed: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE name = ?", "trace-user-2", "trace-user"); err != nil {
+		t.Fatalf("UPDATE failed: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE name = ?", "trace-user-2"); err != nil {
+		t.Fatalf("DELETE failed: %v", err)
+	}
+	parent.End()
+
+	// MySQL は引数付きの ExecContext / QueryContext で driver.ErrSkip を返すため、
+	// スパンはプリペアドステートメントの sql.stmt.exec / sql.stmt.query になる
+	type spanKey struct{ name, op string }
+	spans := map[spanKey]tracetest.SpanStub{}
+	for _, s := range childSpans(exporter, parent) {
+		if s.Name == "sql.exec" || s.Name == "sql.query" {
+			t.Errorf("expected no span for the skipped attempt, got %s", s.Name)
+		}
+		if op := spanAttr(s, attrDBOperation).AsString(); op != "" {
+			spans[spanKey{s.Name, op}] = s
+		}
+		if got := spanAttr(s, attrDBSystem).AsString(); got != "mysql" {
+			t.Errorf("%s: expected db.system mysql, got %q", s.Name, got)
+		}
+		if s.SpanKind != trace.SpanKindClient {
+			t.Errorf("%s: expected client span, got %v", s.Name, s.SpanKind)
+		}
+	}
+	for _, key := range []spanKey{
+		{"sql.stmt.exec", "INSERT"},
+		{"sql.stmt.query", "SELECT"},
+		{"sql.stmt.exec", "UPDATE"},
+		{"sql.stmt.exec", "DELETE"},
+	} {
+		if _, ok := spans[key]; !ok {
+			t.Errorf("expected %s %s span under parent, got %v", key.name, key.op, spanNames(exporter.GetSpans()))
+		}
+	}
+	if got := spanAttr(spans[spanKey{"sql.stmt.exec", "UPDATE"}], attrDBRowsAffected).AsInt64(); got != 1 {
+		t.Errorf("expected 1 row affected by UPDATE, got %d", got)
+	}
+	if got := spanAttr(spans[spanKey{"sql.stmt.query", "SELECT"}], attrDBRowsReturned).AsInt64(); got != 1 {
+		t.Errorf("expected 1 row returned by SELECT, got %d", got)
+	}
+}
+
+func TestTracing_SkippedExecNotExported(t *testing.T) {
+	db, exporter, ctx, parent := newTracedDB(t, &staticConnector{conn: &skipConn{}})
+
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", 1); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+	parent.End()
+
+	names := spanNames(childSpans(exporter, parent))
+	var stmtExec bool
+	for _, name := range names {
+		switch name {
+		case "sql.exec":
+			t.Errorf("expected no span for driver.ErrSkip, got %v", names)
+		case "sql.stmt.exec":
+			stmtExec = true
+		}
+	}
+	if !stmtExec {
+		t.Errorf("expected sql.stmt.exec span, got %v", names)
+	}
+}
+
+func TestPostgreSQL_TracingTransaction(t *testing.T) {
+	truncatePgUsers(t)
+	db, exporter, ctx, parent := newTracedDB(t, pgConnector)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO users (name) VALUES ($1)", "trace-tx-user"); err != nil {
+		tx.Rollback()
+		t.Fatalf("INSERT in tx failed: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO no_such_table (name) VALUES ($1)", "trace-tx-user"); err == nil {
+		t.Errorf("expected INSERT into missing table to fail")
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	parent.End()
+
+	children := childSpans(exporter, parent)
+	names := spanNames(children)
+	for _, want := range []string{"sql.begin", "sql.rollback"} {
+		var found bool
+		for _, s := range children {
+			if s.Name != want {
+				continue
+			}
+			found = true
+			if got := spanAttr(s, attrDBSystem).AsString(); got != "postgresql" {
+				t.Errorf("%s: expected db.system postgresql, got %q", s.Name, got)
+			}
+		}
+		if !found {
+			t.Errorf("expected %s span under parent, got %v", want, names)
+		}
+	}
+
+	var failed bool
+	for _, s := range children {
+		if s.Status.Code == codes.Error && spanAttr(s, attrDBStatement).AsString() == "INSERT INTO no_such_table (name) VALUES ($1)" &&
+			spanAttr(s, attrErrorType).AsString() == string(CategoryUndefinedObject) {
+			failed = true
+		}
+	}
+	if !failed {
+		t.Errorf("expected failed INSERT span with error status, got %v", names)
+	}
+}
@@ -1,8 +1,9 @@
 package customdriver
 
 import (
+	"context"
 	"database/sql/driver"
-	"log/slog"
+	"sync"
 	"time"
 )
 
@@ -10,45 +11,128 @@ var (
 	_ driver.Tx = (*customTx)(nil)
 )
 
+// TxSummary はトランザクション全体の要約で、OpCommit / OpRollback の Event に設定される
+type TxSummary struct {
+	Isolation driver.IsolationLevel
+	ReadOnly  bool
+	Begin     time.Time
+	// Begin から Commit / Rollback の完了までの時間
+	Lifetime time.Duration
+	// Lifetime のうちステートメントを実行していなかった時間
+	Idle         time.Duration
+	Statements   int
+	RowsAffected int64
+	// トランザクション内で実行したステートメント。上限を超えた場合は古いものから捨てる
+	Journal        []TxStatement
+	JournalDropped int
+}
+
+// TxStatement はトランザクション内で実行された 1 つのステートメント
+type TxStatement struct {
+	Query        string
+	QueryName    string
+	Args         []driver.NamedValue
+	Start        time.Time
+	Duration     time.Duration
+	RowsAffected int64
+	Err          error
+}
+
 type customTx struct {
-	tx     driver.Tx
-	logger *slog.Logger
+	tx   driver.Tx
+	conn *customConn
+	cfg  *config
+	id   uint64
+	// Commit / Rollback は context を受け取らないため、BeginTx の context の値を引き継ぐ。
+	// キャンセルは引き継がない
+	ctx context.Context
+	// LeakDetector に記録した ID
+	leakID uint64
+
+	// Rows の Close は別ゴルーチンから呼ばれることがあるため mu で保護する
+	mu      sync.Mutex
+	summary TxSummary
+	busy    time.Duration
 }
 
-func (t *customTx) Commit() error {
-	start := time.Now()
-	err := t.tx.Commit()
-	duration := time.Since(start)
-
-	if err != nil {
-		t.logger.Error("transaction commit failed",
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		t.logger.Info("transaction committed",
-			slog.Duration("duration", duration),
-		)
+func newCustomTx(ctx context.Context, tx driver.Tx, conn *customConn, begin *Event) driver.Tx {
+	t := &customTx{
+		tx:   tx,
+		conn: conn,
+		cfg:  conn.cfg,
+		id:   begin.TxID,
+		ctx:  context.WithoutCancel(ctx),
+		summary: TxSummary{
+			Isolation: begin.TxOptions.Isolation,
+			ReadOnly:  begin.TxOptions.ReadOnly,
+			Begin:     begin.Start,
+		},
+		leakID: conn.cfg.leaks.track(ObjectTx, conn.id, ""),
 	}
+	conn.tx = t
+	return t
+}
 
-	return err
+func (t *customTx) Commit() error {
+	return t.finish(OpCommit, t.tx.Commit)
 }
 
 func (t *customTx) Rollback() error {
-	start := time.Now()
-	err := t.tx.Rollback()
-	duration := time.Since(start)
-
-	if err != nil {
-		t.logger.Error("transaction rollback failed",
-			slog.Duration("duration", duration),
-			slog.Any("error", err),
-		)
-	} else {
-		t.logger.Info("transaction rolled back",
-			slog.Duration("duration", duration),
-		)
-	}
+	return t.finish(OpRollback, t.tx.Rollback)
+}
+
+func (t *customTx) finish(op Op, fn func() error) error {
+	e := t.conn.newEvent(op)
+	e.TxID = t.id
+	ctx := t.cfg.hooks.before(t.ctx, e)
+	err := fn()
+	t.conn.tx = nil
+	t.cfg.leaks.untrack(t.leakID)
+	e.Tx = t.summarize()
+	t.cfg.hooks.after(ctx, e, err)
 
 	return err
 }
+
+// record はトランザクション内で実行された Exec / Query を記録する
+func (t *customTx) record(e *Event) {
+	st := TxStatement{
+		Query:        e.Query,
+		QueryName:    e.QueryName,
+		Args:         e.Args,
+		Start:        e.Start,
+		Duration:     e.Duration,
+		RowsAffected: e.RowsAffected,
+		Err:          e.Err,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.summary.Statements++
+	t.summary.RowsAffected += st.RowsAffected
+	t.busy += st.Duration
+	if t.cfg.txJournalSize <= 0 {
+		return
+	}
+	if len(t.summary.Journal) == t.cfg.txJournalSize {
+		t.summary.Journal = t.summary.Journal[1:]
+		t.summary.JournalDropped++
+	}
+	t.summary.Journal = append(t.summary.Journal, st)
+}
+
+// addBusy はクエリ結果の読み出しにかかった時間をステートメントの実行時間に加える
+func (t *customTx) addBusy(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.busy += d
+}
+
+func (t *customTx) summarize() *TxSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.summary
+	s.Lifetime = time.Since(s.Begin)
+	s.Idle = max(s.Lifetime-t.busy, 0)
+	return &s
+}
@@ -0,0 +1,119 @@
+package customdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+)
+
+// =============================================================================
+// Transaction Summary Tests
+// =============================================================================
+
+func TestMySQL_TxSummary(t *testing.T) {
+	truncateMySQLUsers(t)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	hook := &recordingHook{name: "tx"}
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger, WithHooks(hook), WithTxJournalSize(2)))
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	for _, name := range []string{"tx-1", "tx-2", "tx-3"} {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", name); err != nil {
+			tx.Rollback()
+			t.Fatalf("INSERT in tx failed: %v", err)
+		}
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	e, ok := hook.find(OpRollback)
+	if !ok || e.Tx == nil {
+		t.Fatalf("expected rollback event with summary, got %v", hook.ops())
+	}
+	s := e.Tx
+	if sql.IsolationLevel(s.Isolation) != sql.LevelSerializable || s.ReadOnly {
+		t.Errorf("unexpected tx options: isolation=%d read_only=%v", s.Isolation, s.ReadOnly)
+	}
+	if s.Statements != 3 || s.RowsAffected != 3 {
+		t.Errorf("expected 3 statements and 3 rows affected, got %d and %d", s.Statements, s.RowsAffected)
+	}
+	if len(s.Journal) != 2 || s.JournalDropped != 1 {
+		t.Fatalf("expected 2 journal entries and 1 dropped, got %d and %d", len(s.Journal), s.JournalDropped)
+	}
+	if got := s.Journal[1].Args[0].Value; got != "tx-3" {
+		t.Errorf("expected last journal entry for tx-3, got %v", got)
+	}
+	if s.Lifetime <= 0 || s.Idle < 0 || s.Idle > s.Lifetime {
+		t.Errorf("unexpected lifetime %v and idle %v", s.Lifetime, s.Idle)
+	}
+
+	var found bool
+	for _, r := range decodeLogs(t, &buf) {
+		if r["msg"] != "transaction rolled back" {
+			continue
+		}
+		found = true
+		if r["statements"] != float64(3) {
+			t.Errorf("expected statements=3, got %v", r["statements"])
+		}
+		if journal, ok := r["journal"].([]any); !ok || len(journal) != 2 {
+			t.Errorf("expected journal with 2 entries, got %v", r["journal"])
+		}
+	}
+	if !found {
+		t.Errorf("expected rollback record")
+	}
+}
+
+func TestMySQL_TxSummaryCommit(t *testing.T) {
+	truncateMySQLUsers(t)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	db := sql.OpenDB(NewCustomConnector(mysqlConnector, logger))
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM users")
+	if err != nil {
+		tx.Rollback()
+		t.Fatalf("QueryContext failed: %v", err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	var found bool
+	for _, r := range decodeLogs(t, &buf) {
+		if r["msg"] != "transaction committed" {
+			continue
+		}
+		found = true
+		if r["statements"] != float64(1) || r["read_only"] != true {
+			t.Errorf("unexpected summary %v", r)
+		}
+		if _, ok := r["journal"]; ok {
+			t.Errorf("expected no journal on commit, got %v", r["journal"])
+		}
+	}
+	if !found {
+		t.Errorf("expected commit record")
+	}
+}
@@ -0,0 +1,143 @@
+package customdriver
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+//go:generate go run gen_wrap.go
+
+// database/sql は任意インターフェースを型アサーションで判定して挙動を変えるため、
+// ラッパーは内部の Conn / Stmt / Rows が実装するインターフェースだけを公開する。
+// 例外として、context 非対応の Exec / Query しか持たない場合も context 対応のインターフェースを公開する (legacy.go)。
+// 各ビットの順序は gen_wrap.go の定義と一致させること。
+
+const (
+	connBeginTx uint = 1 << iota
+	connPrepareContext
+	connExecer
+	connExecerContext
+	connQueryer
+	connQueryerContext
+	connPinger
+	connSessionResetter
+	connValidator
+	connNamedValueChecker
+)
+
+const (
+	stmtExecContext uint = 1 << iota
+	stmtQueryContext
+	stmtColumnConverter
+	stmtNamedValueChecker
+)
+
+const (
+	rowsNextResultSetBit uint = 1 << iota
+	rowsColumnTypeDatabaseTypeNameBit
+	rowsColumnTypeLengthBit
+	rowsColumnTypeNullableBit
+	rowsColumnTypePrecisionScaleBit
+	rowsColumnTypeScanTypeBit
+)
+
+// driver.RowsNextResultSet などは driver.Rows を埋め込んでいるため、
+// 構造体に並べて埋め込むとメソッドが曖昧になる。追加メソッドだけを持つインターフェースを使う。
+type (
+	rowsNextResultSet interface {
+		HasNextResultSet() bool
+		NextResultSet() error
+	}
+	rowsColumnTypeDatabaseTypeName interface {
+		ColumnTypeDatabaseTypeName(index int) string
+	}
+	rowsColumnTypeLength interface {
+		ColumnTypeLength(index int) (length int64, ok bool)
+	}
+	rowsColumnTypeNullable interface {
+		ColumnTypeNullable(index int) (nullable, ok bool)
+	}
+	rowsColumnTypePrecisionScale interface {
+		ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool)
+	}
+	rowsColumnTypeScanType interface {
+		ColumnTypeScanType(index int) reflect.Type
+	}
+)
+
+func wrapConn(c *customConn) driver.Conn {
+	var mask uint
+	if _, ok := c.conn.(driver.ConnBeginTx); ok {
+		mask |= connBeginTx
+	}
+	if _, ok := c.conn.(driver.ConnPrepareContext); ok {
+		mask |= connPrepareContext
+	}
+	if _, ok := c.conn.(driver.Execer); ok {
+		mask |= connExecer
+	}
+	if _, ok := c.conn.(driver.ExecerContext); ok {
+		mask |= connExecerContext
+	}
+	// Execer だけを持つ場合も ExecerContext を公開し、フックに context を渡す
+	if mask&connExecer != 0 {
+		mask |= connExecerContext
+	}
+	if _, ok := c.conn.(driver.Queryer); ok {
+		mask |= connQueryer
+	}
+	if _, ok := c.conn.(driver.QueryerContext); ok {
+		mask |= connQueryerContext
+	}
+	if mask&connQueryer != 0 {
+		mask |= connQueryerContext
+	}
+	if _, ok := c.conn.(driver.Pinger); ok {
+		mask |= connPinger
+	}
+	if _, ok := c.conn.(driver.SessionResetter); ok {
+		mask |= connSessionResetter
+	}
+	if _, ok := c.conn.(driver.Validator); ok {
+		mask |= connValidator
+	}
+	if _, ok := c.conn.(driver.NamedValueChecker); ok {
+		mask |= connNamedValueChecker
+	}
+	return wrapConnMask(c, mask)
+}
+
+func wrapStmt(s *customStmt) driver.Stmt {
+	// driver.Stmt は必ず Exec / Query を持つため、context 対応のインターフェースは常に公開する
+	mask := stmtExecContext | stmtQueryContext
+	if _, ok := s.stmt.(driver.ColumnConverter); ok {
+		mask |= stmtColumnConverter
+	}
+	if _, ok := s.stmt.(driver.NamedValueChecker); ok {
+		mask |= stmtNamedValueChecker
+	}
+	return wrapStmtMask(s, mask)
+}
+
+func wrapRows(r *customRows) driver.Rows {
+	var mask uint
+	if _, ok := r.rows.(driver.RowsNextResultSet); ok {
+		mask |= rowsNextResultSetBit
+	}
+	if _, ok := r.rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		mask |= rowsColumnTypeDatabaseTypeNameBit
+	}
+	if _, ok := r.rows.(driver.RowsColumnTypeLength); ok {
+		mask |= rowsColumnTypeLengthBit
+	}
+	if _, ok := r.rows.(driver.RowsColumnTypeNullable); ok {
+		mask |= rowsColumnTypeNullableBit
+	}
+	if _, ok := r.rows.(driver.RowsColumnTypePrecisionScale); ok {
+		mask |= rowsColumnTypePrecisionScaleBit
+	}
+	if _, ok := r.rows.(driver.RowsColumnTypeScanType); ok {
+		mask |= rowsColumnTypeScanTypeBit
+	}
+	return wrapRowsMask(r, mask)
+}
@@ -0,0 +1,9777 @@
+// Code generated by gen_wrap.go. DO NOT EDIT.
+
+package customdriver
+
+import "database/sql/driver"
+
+func wrapConnMask(w *customConn, mask uint) driver.Conn {
+	switch mask {
+	case 0x0:
+		return struct{ driver.Conn }{w}
+	case 0x1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+		}{w, w}
+	case 0x2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+		}{w, w}
+	case 0x3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+		}{w, w, w}
+	case 0x4:
+		return struct {
+			driver.Conn
+			driver.Execer
+		}{w, w}
+	case 0x5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+		}{w, w, w}
+	case 0x6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+		}{w, w, w}
+	case 0x7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+		}{w, w, w, w}
+	case 0x8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+		}{w, w}
+	case 0x9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+		}{w, w, w}
+	case 0xa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+		}{w, w, w}
+	case 0xb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 0xc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w}
+	case 0xd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 0xe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w}
+	case 0xf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+		}{w, w, w, w, w}
+	case 0x10:
+		return struct {
+			driver.Conn
+			driver.Queryer
+		}{w, w}
+	case 0x11:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+		}{w, w, w}
+	case 0x12:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+		}{w, w, w}
+	case 0x13:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x14:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+		}{w, w, w}
+	case 0x15:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x16:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x17:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+		}{w, w, w, w, w}
+	case 0x18:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w}
+	case 0x19:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x1a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x1b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w, w}
+	case 0x1c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w}
+	case 0x1d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w, w}
+	case 0x1e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w, w}
+	case 0x1f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+		}{w, w, w, w, w, w}
+	case 0x20:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+		}{w, w}
+	case 0x21:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+		}{w, w, w}
+	case 0x22:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+		}{w, w, w}
+	case 0x23:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x24:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+		}{w, w, w}
+	case 0x25:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x26:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x27:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x28:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w}
+	case 0x29:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x2a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x2b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x2c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x2d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x2e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x2f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+		}{w, w, w, w, w, w}
+	case 0x30:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w}
+	case 0x31:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x32:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x33:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x34:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x35:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x36:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x37:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w, w}
+	case 0x38:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w}
+	case 0x39:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x3a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x3b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w, w}
+	case 0x3c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w}
+	case 0x3d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w, w}
+	case 0x3e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w, w}
+	case 0x3f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+		}{w, w, w, w, w, w, w}
+	case 0x40:
+		return struct {
+			driver.Conn
+			driver.Pinger
+		}{w, w}
+	case 0x41:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+		}{w, w, w}
+	case 0x42:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w}
+	case 0x43:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x44:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+		}{w, w, w}
+	case 0x45:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x46:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x47:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x48:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w}
+	case 0x49:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x4a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x4b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x4c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x4d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x4e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x4f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x50:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w}
+	case 0x51:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x52:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x53:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x54:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x55:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x56:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x57:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x58:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x59:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x5a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x5b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x5c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x5d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x5e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x5f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x60:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w}
+	case 0x61:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x62:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x63:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x64:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x65:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x66:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x67:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x68:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x69:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x6a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x6b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x6c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x6d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x6e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x6f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x70:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w}
+	case 0x71:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x72:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x73:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x74:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x75:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x76:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x77:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x78:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w}
+	case 0x79:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x7a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x7b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x7c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w}
+	case 0x7d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x7e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w}
+	case 0x7f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+		}{w, w, w, w, w, w, w, w}
+	case 0x80:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+		}{w, w}
+	case 0x81:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+		}{w, w, w}
+	case 0x82:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 0x83:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x84:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w}
+	case 0x85:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x86:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x87:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x88:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 0x89:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x8a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x8b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x8c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x8d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x8e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x8f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0x90:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w}
+	case 0x91:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x92:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x93:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x94:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x95:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x96:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x97:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0x98:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0x99:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x9a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x9b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0x9c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0x9d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0x9e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0x9f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xa0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w}
+	case 0xa1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xa2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xa3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xa4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xa5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xa6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xa7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xa8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xa9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xaa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xab:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xac:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xad:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xae:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xaf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xb0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xb1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xb2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xb3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xb4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xb5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xb6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xb7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xb8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xb9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xba:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xbb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xbc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xbd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xbe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xbf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xc0:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w}
+	case 0xc1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xc2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xc3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xc4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xc5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xc6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xc7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xc8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xc9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xca:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xcb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xcc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xcd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xce:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xcf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xd0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xd1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xd2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xd3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xd4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xd5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xd6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xd7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xd8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xd9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xda:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xdb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xdc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xdd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xde:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xdf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xe0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w}
+	case 0xe1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xe2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xe3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xe4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xe5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xe6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xe7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xe8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xe9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xea:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xeb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xec:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xed:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xee:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xef:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xf0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w}
+	case 0xf1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xf2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xf3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xf4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xf5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xf6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xf7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xf8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w}
+	case 0xf9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xfa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xfb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xfc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w}
+	case 0xfd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xfe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w}
+	case 0xff:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x100:
+		return struct {
+			driver.Conn
+			driver.Validator
+		}{w, w}
+	case 0x101:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+		}{w, w, w}
+	case 0x102:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w}
+	case 0x103:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x104:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+		}{w, w, w}
+	case 0x105:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x106:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x107:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x108:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w}
+	case 0x109:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x10a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x10b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x10c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x10d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x10e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x10f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x110:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+		}{w, w, w}
+	case 0x111:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x112:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x113:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x114:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x115:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x116:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x117:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x118:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w}
+	case 0x119:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x11a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x11b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x11c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x11d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x11e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x11f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x120:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w}
+	case 0x121:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x122:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x123:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x124:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x125:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x126:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x127:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x128:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x129:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x12a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x12b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x12c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x12d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x12e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x12f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x130:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w}
+	case 0x131:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x132:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x133:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x134:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x135:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x136:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x137:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x138:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x139:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x13a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x13b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x13c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x13d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x13e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x13f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x140:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+		}{w, w, w}
+	case 0x141:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x142:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x143:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x144:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x145:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x146:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x147:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x148:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x149:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x14a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x14b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x14c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x14d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x14e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x14f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x150:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x151:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x152:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x153:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x154:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x155:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x156:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x157:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x158:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x159:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x15a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x15b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x15c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x15d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x15e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x15f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x160:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w}
+	case 0x161:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x162:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x163:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x164:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x165:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x166:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x167:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x168:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x169:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x16a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x16b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x16c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x16d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x16e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x16f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x170:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x171:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x172:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x173:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x174:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x175:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x176:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x177:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x178:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x179:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x17a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x17b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x17c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x17d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x17e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x17f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x180:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w}
+	case 0x181:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x182:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x183:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x184:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x185:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x186:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x187:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x188:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x189:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x18a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x18b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x18c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x18d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x18e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x18f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x190:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x191:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x192:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x193:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x194:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x195:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x196:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x197:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x198:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x199:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x19a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x19b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x19c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x19d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x19e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x19f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1a0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x1a1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1a2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1a3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1a4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1a5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1a6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1a7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1a8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1a9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1aa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1ab:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ac:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1ad:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ae:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1af:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1b0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1b1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1b2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1b3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1b4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1b5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1b6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1b7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1b8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1b9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ba:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1bb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1bc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1bd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1be:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1bf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1c0:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w}
+	case 0x1c1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1c2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1c3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1c4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1c5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1c6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1c7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1c8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1c9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1ca:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1cb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1cc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1cd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ce:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1cf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1d0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1d1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1d2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1d3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1d4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1d5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1d6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1d7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1d8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1d9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1da:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1db:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1dc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1dd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1de:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1df:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1e0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w}
+	case 0x1e1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1e2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1e3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1e4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1e5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1e6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1e7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1e8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1e9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ea:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1eb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1ec:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1ed:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1ee:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1ef:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1f0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w}
+	case 0x1f1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1f2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1f3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1f4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1f5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1f6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1f7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1f8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w}
+	case 0x1f9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1fa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1fb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1fc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w}
+	case 0x1fd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1fe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x1ff:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x200:
+		return struct {
+			driver.Conn
+			driver.NamedValueChecker
+		}{w, w}
+	case 0x201:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x202:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x203:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x204:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x205:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x206:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x207:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x208:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x209:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x20a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x20b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x20c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x20d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x20e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x20f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x210:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x211:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x212:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x213:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x214:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x215:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x216:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x217:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x218:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x219:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x21a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x21b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x21c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x21d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x21e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x21f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x220:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x221:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x222:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x223:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x224:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x225:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x226:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x227:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x228:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x229:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x22a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x22b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x22c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x22d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x22e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x22f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x230:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x231:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x232:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x233:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x234:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x235:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x236:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x237:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x238:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x239:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x23a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x23b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x23c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x23d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x23e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x23f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x240:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x241:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x242:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x243:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x244:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x245:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x246:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x247:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x248:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x249:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x24a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x24b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x24c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x24d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x24e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x24f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x250:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x251:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x252:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x253:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x254:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x255:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x256:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x257:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x258:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x259:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x25a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x25b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x25c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x25d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x25e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x25f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x260:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x261:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x262:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x263:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x264:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x265:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x266:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x267:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x268:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x269:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x26a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x26b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x26c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x26d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x26e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x26f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x270:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x271:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x272:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x273:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x274:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x275:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x276:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x277:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x278:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x279:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x27a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x27b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x27c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x27d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x27e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x27f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x280:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x281:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x282:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x283:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x284:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x285:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x286:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x287:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x288:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x289:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x28a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x28b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x28c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x28d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x28e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x28f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x290:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x291:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x292:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x293:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x294:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x295:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x296:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x297:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x298:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x299:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x29a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x29b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x29c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x29d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x29e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x29f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2a0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x2a1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2a2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2a3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2a4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2a5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2a6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2a7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2a8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2a9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2aa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2ab:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ac:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2ad:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ae:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2af:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2b0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2b1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2b2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2b3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2b4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2b5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2b6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2b7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2b8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2b9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ba:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2bb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2bc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2bd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2be:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2bf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2c0:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x2c1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2c2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2c3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2c4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2c5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2c6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2c7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2c8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2c9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2ca:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2cb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2cc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2cd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ce:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2cf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2d0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2d1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2d2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2d3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2d4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2d5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2d6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2d7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2d8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2d9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2da:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2db:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2dc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2dd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2de:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2df:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2e0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x2e1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2e2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2e3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2e4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2e5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2e6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2e7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2e8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2e9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ea:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2eb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2ec:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2ed:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2ee:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2ef:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2f0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x2f1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2f2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2f3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2f4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2f5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2f6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2f7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2f8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x2f9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2fa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2fb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2fc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x2fd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2fe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x2ff:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x300:
+		return struct {
+			driver.Conn
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0x301:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x302:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x303:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x304:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x305:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x306:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x307:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x308:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x309:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x30a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x30b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x30c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x30d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x30e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x30f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x310:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x311:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x312:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x313:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x314:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x315:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x316:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x317:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x318:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x319:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x31a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x31b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x31c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x31d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x31e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x31f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x320:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x321:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x322:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x323:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x324:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x325:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x326:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x327:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x328:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x329:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x32a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x32b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x32c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x32d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x32e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x32f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x330:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x331:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x332:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x333:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x334:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x335:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x336:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x337:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x338:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x339:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x33a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x33b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x33c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x33d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x33e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x33f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x340:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x341:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x342:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x343:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x344:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x345:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x346:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x347:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x348:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x349:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x34a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x34b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x34c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x34d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x34e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x34f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x350:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x351:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x352:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x353:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x354:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x355:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x356:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x357:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x358:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x359:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x35a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x35b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x35c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x35d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x35e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x35f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x360:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x361:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x362:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x363:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x364:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x365:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x366:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x367:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x368:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x369:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x36a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x36b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x36c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x36d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x36e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x36f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x370:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x371:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x372:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x373:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x374:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x375:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x376:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x377:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x378:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x379:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x37a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x37b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x37c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x37d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x37e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x37f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x380:
+		return struct {
+			driver.Conn
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0x381:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x382:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x383:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x384:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x385:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x386:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x387:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x388:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x389:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x38a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x38b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x38c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x38d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x38e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x38f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x390:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x391:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x392:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x393:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x394:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x395:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x396:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x397:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x398:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x399:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x39a:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x39b:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x39c:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x39d:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x39e:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x39f:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3a0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x3a1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3a2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3a3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3a4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3a5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3a6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3a7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3a8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3a9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3aa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3ab:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ac:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3ad:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ae:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3af:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3b0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3b1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3b2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3b3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3b4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3b5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3b6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3b7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3b8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3b9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ba:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3bb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3bc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3bd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3be:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3bf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3c0:
+		return struct {
+			driver.Conn
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	case 0x3c1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3c2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3c3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3c4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3c5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3c6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3c7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3c8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3c9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3ca:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3cb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3cc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3cd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ce:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3cf:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3d0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3d1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3d2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3d3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3d4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3d5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3d6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3d7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3d8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3d9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3da:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3db:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3dc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3dd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3de:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3df:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3e0:
+		return struct {
+			driver.Conn
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w}
+	case 0x3e1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3e2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3e3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3e4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3e5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3e6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3e7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3e8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3e9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ea:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3eb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3ec:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3ed:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3ee:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3ef:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3f0:
+		return struct {
+			driver.Conn
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w}
+	case 0x3f1:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3f2:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3f3:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3f4:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3f5:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3f6:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3f7:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3f8:
+		return struct {
+			driver.Conn
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w}
+	case 0x3f9:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3fa:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3fb:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3fc:
+		return struct {
+			driver.Conn
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w}
+	case 0x3fd:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3fe:
+		return struct {
+			driver.Conn
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w}
+	case 0x3ff:
+		return struct {
+			driver.Conn
+			driver.ConnBeginTx
+			driver.ConnPrepareContext
+			driver.Execer
+			driver.ExecerContext
+			driver.Queryer
+			driver.QueryerContext
+			driver.Pinger
+			driver.SessionResetter
+			driver.Validator
+			driver.NamedValueChecker
+		}{w, w, w, w, w, w, w, w, w, w, w}
+	}
+	panic("customdriver: unexpected mask for driver.Conn")
+}
+
+func wrapStmtMask(w *customStmt, mask uint) driver.Stmt {
+	switch mask {
+	case 0x0:
+		return struct{ driver.Stmt }{w}
+	case 0x1:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+		}{w, w}
+	case 0x2:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+		}{w, w}
+	case 0x3:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+		}{w, w, w}
+	case 0x4:
+		return struct {
+			driver.Stmt
+			driver.ColumnConverter
+		}{w, w}
+	case 0x5:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.ColumnConverter
+		}{w, w, w}
+	case 0x6:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+			driver.ColumnConverter
+		}{w, w, w}
+	case 0x7:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+			driver.ColumnConverter
+		}{w, w, w, w}
+	case 0x8:
+		return struct {
+			driver.Stmt
+			driver.NamedValueChecker
+		}{w, w}
+	case 0x9:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0xa:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0xb:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0xc:
+		return struct {
+			driver.Stmt
+			driver.ColumnConverter
+			driver.NamedValueChecker
+		}{w, w, w}
+	case 0xd:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.ColumnConverter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0xe:
+		return struct {
+			driver.Stmt
+			driver.StmtQueryContext
+			driver.ColumnConverter
+			driver.NamedValueChecker
+		}{w, w, w, w}
+	case 0xf:
+		return struct {
+			driver.Stmt
+			driver.StmtExecContext
+			driver.StmtQueryContext
+			driver.ColumnConverter
+			driver.NamedValueChecker
+		}{w, w, w, w, w}
+	}
+	panic("customdriver: unexpected mask for driver.Stmt")
+}
+
+func wrapRowsMask(w *customRows, mask uint) driver.Rows {
+	switch mask {
+	case 0x0:
+		return struct{ driver.Rows }{w}
+	case 0x1:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+		}{w, w}
+	case 0x2:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+		}{w, w}
+	case 0x3:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+		}{w, w, w}
+	case 0x4:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+		}{w, w}
+	case 0x5:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 0x6:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w}
+	case 0x7:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{w, w, w, w}
+	case 0x8:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+		}{w, w}
+	case 0x9:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 0xa:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 0xb:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 0xc:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w}
+	case 0xd:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 0xe:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w}
+	case 0xf:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{w, w, w, w, w}
+	case 0x10:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+		}{w, w}
+	case 0x11:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 0x12:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 0x13:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x14:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 0x15:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x16:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x17:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 0x18:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w}
+	case 0x19:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x1a:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x1b:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 0x1c:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w}
+	case 0x1d:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 0x1e:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w}
+	case 0x1f:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{w, w, w, w, w, w}
+	case 0x20:
+		return struct {
+			driver.Rows
+			rowsColumnTypeScanType
+		}{w, w}
+	case 0x21:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+		}{w, w, w}
+	case 0x22:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeScanType
+		}{w, w, w}
+	case 0x23:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x24:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeScanType
+		}{w, w, w}
+	case 0x25:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x26:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x27:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x28:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w}
+	case 0x29:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x2a:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x2b:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x2c:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x2d:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x2e:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x2f:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w}
+	case 0x30:
+		return struct {
+			driver.Rows
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w}
+	case 0x31:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x32:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x33:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x34:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x35:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x36:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x37:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w}
+	case 0x38:
+		return struct {
+			driver.Rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w}
+	case 0x39:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x3a:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x3b:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w}
+	case 0x3c:
+		return struct {
+			driver.Rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w}
+	case 0x3d:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w}
+	case 0x3e:
+		return struct {
+			driver.Rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w}
+	case 0x3f:
+		return struct {
+			driver.Rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+			rowsColumnTypeScanType
+		}{w, w, w, w, w, w, w}
+	}
+	panic("customdriver: unexpected mask for driver.Rows")
+}